@@ -1,9 +1,16 @@
 package api
 
+// IVisitor is implemented by nodes that traverse their own children
+// instead of being traversed by nodes.Visit, for example, filters
+// and cameras.
+type IVisitor interface {
+	Visit(context IRenderContext, interpolation float64)
+}
+
 // IFilter represents Transform Filter nodes
 type IFilter interface {
 	Build(IWorld)
-	Visit(context IRenderContext, interpolation float64)
+	IVisitor
 
 	InheritOnlyRotation()
 	InheritOnlyScale()
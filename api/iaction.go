@@ -0,0 +1,30 @@
+package api
+
+// IAction changes a node's properties over time, for example, moving
+// it. Actions are run by an IActionRunner.
+type IAction interface {
+	// Start binds the action to a node and restarts it
+	Start(target INode)
+
+	// Update advances the action by dt milliseconds and returns the
+	// time left over once the action finishes.
+	Update(dt float64) float64
+
+	IsDone() bool
+}
+
+// IActionRunner updates running actions. The node manager's runner is
+// updated at the start of each fixed update.
+type IActionRunner interface {
+	// Run starts an action on a node
+	Run(target INode, action IAction)
+
+	// Stop stops all of a node's actions
+	Stop(target INode)
+	// StopAction stops a single action
+	StopAction(action IAction)
+
+	IsRunning(target INode) bool
+
+	Update(msPerUpdate float64)
+}
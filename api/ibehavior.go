@@ -0,0 +1,32 @@
+package api
+
+const (
+	// BehaviorSuccess means a behavior has finished successfully
+	BehaviorSuccess = 0
+	// BehaviorFailure means a behavior has failed
+	BehaviorFailure = 1
+	// BehaviorRunning means a behavior needs more ticks to finish
+	BehaviorRunning = 2
+)
+
+// IBlackboard is data shared between the behaviors of a tree
+type IBlackboard interface {
+	Set(key string, value interface{})
+	// Get returns nil for a missing key
+	Get(key string) interface{}
+	Has(key string) bool
+	Delete(key string)
+}
+
+// IBehavior is a node of a behaviour tree
+type IBehavior interface {
+	Name() string
+
+	// Tick runs the behavior for dt milliseconds and returns one of
+	// the Behavior status constants.
+	Tick(board IBlackboard, dt float64) int
+
+	// Reset abandons a running behavior so it starts over on the next
+	// tick.
+	Reset()
+}
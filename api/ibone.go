@@ -0,0 +1,20 @@
+package api
+
+// IBone is a skeleton node. Its rest transform is the pose it returns
+// to; animations and IK change its rotation relative to the rest.
+// Meshes attached as children follow the bone.
+type IBone interface {
+	INode
+
+	// SetRest sets the rest position and rotation, and poses the bone
+	// at rest.
+	SetRest(x, y, radians float64)
+	RestRotation() float64
+	// ResetPose returns the bone to its rest position and rotation.
+	ResetPose()
+
+	// SetLength sets the distance from the bone's origin to its tip
+	// along the bone's x-axis.
+	SetLength(length float64)
+	Length() float64
+}
@@ -0,0 +1,53 @@
+package api
+
+// ICamera is a view onto a camera node's children. It follows a
+// tracked point with smoothing and a dead-zone, stays within optional
+// bounds and can shake.
+type ICamera interface {
+	// SetPosition moves the camera immediately and stops any tracking motion.
+	SetPosition(x, y float64)
+	Position() IPoint
+
+	// SetZoom sets the magnification, 1.0 is none
+	SetZoom(zoom float64)
+	Zoom() float64
+
+	SetRotation(radians float64)
+	Rotation() float64
+
+	// SetViewSize sets the visible area in view-space at a zoom of 1.0.
+	// It is used for clamping to bounds.
+	SetViewSize(w, h float64)
+
+	// Track sets the point the camera moves towards on each update.
+	Track(x, y float64)
+	// SetSmoothing is the fraction (0,1] of the remaining distance
+	// closed per update. 1.0 snaps to the tracked point.
+	SetSmoothing(smoothing float64)
+	// SetDeadZone sets a centered area in which the tracked point can
+	// move without moving the camera.
+	SetDeadZone(w, h float64)
+
+	// SetBounds keeps the visible area within a rectangle
+	SetBounds(minX, minY, maxX, maxY float64)
+	ClearBounds()
+
+	// Shake offsets the camera randomly by up to magnitude, decaying
+	// over duration milliseconds.
+	Shake(magnitude, duration float64)
+
+	Update(msPerUpdate float64)
+	Interpolate(interpolation float64)
+
+	// Transform maps camera-space (the camera node's children) into
+	// view-space relative to the viewport's center.
+	Transform() IAffineTransform
+}
+
+// ICameraNode is implemented by nodes that render their children
+// through cameras and viewports. The space mappings use it to map
+// between device-space and the view-space of the active viewport.
+type ICameraNode interface {
+	MapDeviceToView(dvx, dvy int32, viewPoint IPoint)
+	MapViewToDevice(viewPoint, devicePoint IPoint)
+}
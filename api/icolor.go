@@ -11,3 +11,10 @@ type IColor interface {
 	// SetAlpha
 	SetAlpha(r int)
 }
+
+// IColorable represents Nodes with a single color, for example, shapes
+// and text.
+type IColorable interface {
+	SetColor(color IPalette)
+	Color() IPalette
+}
@@ -0,0 +1,7 @@
+package api
+
+// IDescriber describes its current state as text, for example, for a
+// debug overlay.
+type IDescriber interface {
+	Describe() string
+}
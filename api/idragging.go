@@ -10,4 +10,8 @@ type IDragging interface {
 
 	SetMotionStateUsing(x, y int32, state uint32, node INode)
 	SetButtonStateUsing(x, y int32, button uint8, state uint32, node INode)
+
+	// SetRaiseOnDrag brings the node passed to SetButtonStateUsing to
+	// the front while it is dragged. Its z-index is restored on release.
+	SetRaiseOnDrag(raise bool)
 }
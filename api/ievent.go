@@ -11,6 +11,8 @@ const (
 	IOTypeMouseButtonUp = 1026
 	// IOTypeMouseWheel is a mouse event
 	IOTypeMouseWheel = 1027
+	// IOTypeTextInput is a text input event carrying UTF-8 text
+	IOTypeTextInput = 771
 )
 
 // IEvent represents IO event system
@@ -51,4 +53,7 @@ type IEvent interface {
 	GetKeyCode() uint32
 	SetKeyMotif(uint32)
 	GetKeyMotif() uint32
+
+	SetText(string)
+	GetText() string
 }
@@ -7,4 +7,25 @@ type IGroup interface {
 	Children() []INode
 
 	AddChild(INode)
+
+	// RemoveChild removes a child and clears its parent. It returns
+	// false if the node isn't a child.
+	RemoveChild(child INode) bool
+	// InsertAt inserts a child at an index. Draw layer and z-index
+	// ordering still apply.
+	InsertAt(index int, child INode)
+	// IndexOf returns a child's index or -1
+	IndexOf(child INode) int
+	// FindByName returns the first child with the name or nil
+	FindByName(name string) INode
+	// FindByID returns the child with the id or nil
+	FindByID(id int) INode
+
+	// BringToFront moves a child above its siblings in the same draw layer
+	BringToFront(child INode)
+	// SendToBack moves a child below its siblings in the same draw layer
+	SendToBack(child INode)
+	// SortChildren orders the children by draw layer and z-index. It is
+	// called automatically when either changes.
+	SortChildren()
 }
@@ -0,0 +1,50 @@
+package api
+
+// SceneBuilder builds a scene on a loader's worker goroutine. It
+// reports progress via the loader and must use the loader's Call for
+// anything that touches SDL, for example, creating textures. A long
+// builder should return early once the loader IsStopped. A builder
+// that panics is recovered and the loader's Err reports the panic.
+type SceneBuilder func(world IWorld, loader ILoader) INode
+
+// ILoadListener receives a loader's notifications on the render thread
+type ILoadListener interface {
+	// LoadProgress reports the fraction (0.0 -> 1.0) completed
+	LoadProgress(fraction float64)
+	// LoadComplete provides the built scene, or nil if the builder
+	// failed, see the loader's Err.
+	LoadComplete(scene INode)
+}
+
+// ILoader builds a scene in the background
+type ILoader interface {
+	// Start runs the builder on a worker goroutine
+	Start()
+
+	// SetProgress is called by the builder as it progresses
+	SetProgress(fraction float64)
+	Progress() float64
+
+	// Call runs a function on the render thread and waits for it to
+	// finish. It must only be called by the builder. It returns false,
+	// without running the function, if the loader is stopped.
+	Call(fn func()) bool
+
+	// Stop cancels loading, for example, when the loading scene exits.
+	// Pending and future Calls return false and the listener is no
+	// longer notified.
+	Stop()
+	IsStopped() bool
+
+	// Poll runs pending Calls and notifies the listener. It is called
+	// on the render thread, typically from a loading scene's Update.
+	Poll()
+
+	SetListener(listener ILoadListener)
+
+	IsDone() bool
+	// Scene returns the built scene once done
+	Scene() INode
+	// Err returns the builder's panic, if any, once done
+	Err() error
+}
@@ -13,6 +13,9 @@ type IMesh interface {
 
 	SetVertex(x, y float64, index int)
 
+	// Clear removes all vertices
+	Clear()
+
 	// Build
 	Build()
 }
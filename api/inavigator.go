@@ -0,0 +1,27 @@
+package api
+
+// Diagonal rules for grid navigation
+const (
+	// DiagonalNever only moves horizontally and vertically
+	DiagonalNever = iota
+	// DiagonalNoCorners moves diagonally when both cells beside the
+	// move are open, so paths don't cut corners.
+	DiagonalNoCorners
+	// DiagonalOneOpen moves diagonally when at least one cell beside
+	// the move is open.
+	DiagonalOneOpen
+	// DiagonalAlways moves diagonally between any open cells
+	DiagonalAlways
+)
+
+// INavigator finds paths around obstacles, for example, a grid or a
+// navmesh.
+type INavigator interface {
+	// FindPath returns the points from one position to another, or
+	// false if there is no path.
+	FindPath(fromX, fromY, toX, toY float64) ([]IPoint, bool)
+
+	// Edges returns the graph's lines, as pairs of points, for debug
+	// rendering.
+	Edges() []IPoint
+}
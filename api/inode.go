@@ -1,11 +1,30 @@
 package api
 
+// Draw layers are a coarse ordering of siblings. Siblings are drawn
+// by layer and then by z-index.
+const (
+	// DrawLayerBackground draws before the world
+	DrawLayerBackground = iota
+	// DrawLayerWorld is the default layer
+	DrawLayerWorld
+	// DrawLayerHUD draws above the world
+	DrawLayerHUD
+	// DrawLayerDebug draws above everything
+	DrawLayerDebug
+)
+
 // INode is an abstract object that represents SceneGraph nodes
 type INode interface {
 	ID() int
 	SetID(id int)
 	Name() string
 
+	// Tags are free form labels used by queries, for example, "enemy".
+	AddTag(tag string)
+	RemoveTag(tag string)
+	HasTag(tag string) bool
+	Tags() []string
+
 	// Initialize configures default properties.
 	Initialize(name string)
 
@@ -23,12 +42,39 @@ type INode interface {
 
 	Interpolate(interpolation float64)
 
+	// AddMotion binds a motion to the node. The stage's manager updates
+	// it each fixed update and Interpolate passes the interpolated value
+	// to apply, for example, animation.ApplyRotation(node).
+	AddMotion(motion IMotion, apply func(value interface{}))
+	RemoveMotion(motion IMotion)
+
 	EnterNode(INodeManager)
 	ExitNode(INodeManager)
 
 	IsVisible() bool
 	SetVisible(bool)
 
+	// SetOpacity sets the node's opacity (0.0 -> 1.0), which is
+	// multiplied by the parent's while visiting.
+	SetOpacity(opacity float64)
+	Opacity() float64
+
+	// SetBlendMode sets the blend mode used by the node and its
+	// children. BlendInherit (default) uses the parent's.
+	SetBlendMode(mode int)
+	BlendMode() int
+
+	// SetZIndex orders the node among its siblings within its draw
+	// layer. Higher values draw later, i.e. on top. Equal values keep
+	// their insertion order.
+	SetZIndex(z int)
+	ZIndex() int
+
+	// SetDrawLayer places the node in a draw layer, for example,
+	// DrawLayerHUD. The default is DrawLayerWorld.
+	SetDrawLayer(layer int)
+	DrawLayer() int
+
 	IsDirty() bool
 	SetDirty(dirty bool)
 	// RippleDirty passes the dirty flag downward to children.
@@ -1,10 +1,20 @@
 package api
 
-// INodeList is a simple list collection
+// INodeList is a simple list collection that can safely change while
+// it is being iterated.
 type INodeList interface {
+	// Items returns the nodes. While locked, removed nodes are nil.
 	Items() []INode
-	DeleteAt(i int, slice []INode)
-	FindFirstElement(node INode, slice []INode) int
+	DeleteAt(i int)
+	FindFirstElement(node INode) int
+	// Add appends a node unless it is already present
 	Add(node INode)
 	Remove(node INode)
+
+	// Lock defers Add and Remove until the matching Unlock. Removed
+	// nodes are set to nil right away so iterations can skip them.
+	// Locks can nest.
+	Lock()
+	// Unlock applies the deferred changes once the last lock is released
+	Unlock()
 }
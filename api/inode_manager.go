@@ -22,6 +22,10 @@ type INodeManager interface {
 	RegisterEventTarget(target INode)
 	UnRegisterEventTarget(target INode)
 
+	// Actions returns the runner for node actions. A node's actions
+	// are stopped when it exits the stage.
+	Actions() IActionRunner
+
 	End()
 
 	Debug()
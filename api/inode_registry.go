@@ -0,0 +1,19 @@
+package api
+
+// INodeRegistry allocates node ids for a world and maps the ids of
+// nodes in the running scene to the nodes. It is safe for concurrent use.
+type INodeRegistry interface {
+	// NextID returns an id that isn't allocated, reserved or registered
+	NextID() int
+	// Reserve keeps NextID from allocating an id that was set explicitly
+	Reserve(id int)
+
+	// Register maps the node's id to the node. It returns false if a
+	// different node is registered with the id.
+	Register(node INode) bool
+	// Unregister removes the node's mapping
+	Unregister(node INode)
+
+	// Lookup returns the node registered with the id, or nil
+	Lookup(id int) INode
+}
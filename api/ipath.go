@@ -0,0 +1,28 @@
+package api
+
+// IPath is a curve through, or near, a list of points. Positions along
+// the path are given as distances so motion along it has a constant
+// speed.
+type IPath interface {
+	// AddPoint appends a point. Call Build once all points are added.
+	AddPoint(x, y float64)
+	SetPoint(x, y float64, index int)
+	Points() []IPoint
+
+	// SetClosed joins the last point back to the first.
+	SetClosed(closed bool)
+	IsClosed() bool
+
+	// Build measures the path's arc-length.
+	Build()
+
+	// Length returns the path's arc-length.
+	Length() float64
+
+	// PointAt sets out to the point at a distance along the path. Open
+	// paths clamp the distance and closed paths wrap it.
+	PointAt(distance float64, out IPoint)
+	// TangentAt sets out to the normalized direction of the path at a
+	// distance.
+	TangentAt(distance float64, out IVector)
+}
@@ -8,8 +8,12 @@ package api
 type IRasterFont interface {
 	Initialize(dataFile string, relativePath string)
 
-	// Glyph returns an array of vertices that matches the character
-	Glyph(char byte) []uint8
+	// Glyph returns the pixel rows that match the character. A fallback
+	// glyph is returned if the character isn't in the font.
+	Glyph(char rune) []uint8
+
+	// HasGlyph indicates if the font defines the character
+	HasGlyph(char rune) bool
 
 	GlyphWidth() int
 }
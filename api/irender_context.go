@@ -1,5 +1,7 @@
 package api
 
+import "github.com/veandco/go-sdl2/sdl"
+
 const (
 	// FILLED polygon
 	FILLED = 0
@@ -14,6 +16,20 @@ const (
 	OPEN = 1
 )
 
+const (
+	// BlendAlpha blends using the source's alpha
+	BlendAlpha = iota
+	// BlendAdditive adds the source to the destination
+	BlendAdditive
+	// BlendMultiply multiplies the destination by the source
+	BlendMultiply
+	// BlendNone replaces the destination
+	BlendNone
+
+	// BlendInherit is used by nodes to keep the parent's blend mode
+	BlendInherit = -1
+)
+
 // IRenderContext represents visual rendering context
 type IRenderContext interface {
 	// Initialize render context
@@ -34,6 +50,44 @@ type IRenderContext interface {
 	// Post
 	Post()
 
+	// SetViewport restricts rendering to a device-space rectangle and
+	// maps view-space to its center at the world's view scale.
+	// A nil rectangle restores the whole window. The viewport is saved
+	// and restored along with the transform.
+	SetViewport(rect IRectangle)
+
+	// PushClip restricts drawing to the device-space bounds of a
+	// local-space rectangle intersected with the current clip. Clips are
+	// part of the saved state so Restore removes any pushed since Save,
+	// which means a node can push a clip in Draw to clip its children.
+	PushClip(rect IRectangle)
+	// PopClip removes the most recently pushed clip
+	PopClip()
+	// ClipRect returns the current device-space clip or nil if drawing
+	// isn't clipped. Nodes can use it to cull.
+	ClipRect() IRectangle
+
+	// SetBlendMode selects how drawing is combined with the target,
+	// for example, BlendAdditive. It is saved and restored.
+	SetBlendMode(mode int)
+	BlendMode() int
+
+	// SetOpacity sets the opacity (0.0 -> 1.0) that draw colors and
+	// textures are multiplied by. It is saved and restored, and Visit
+	// multiplies it by each node's opacity so that it cascades down
+	// the hierarchy.
+	SetOpacity(opacity float64)
+	Opacity() float64
+
+	// SetTarget redirects drawing into a window sized texture created
+	// with TEXTUREACCESS_TARGET. The texture is cleared to transparent.
+	// nil draws to the window. The target is saved and restored along
+	// with the transform.
+	SetTarget(target *sdl.Texture)
+	// Composite draws a target texture over the current viewport using
+	// a tint and the current opacity and blend mode.
+	Composite(target *sdl.Texture, tint IPalette)
+
 	// TransformPoint transforms an IPoint using the current context.
 	TransformPoint(p, out IPoint)
 
@@ -83,4 +137,10 @@ type IRenderContext interface {
 	RenderAARectangle(min, max IPoint, fill int)
 
 	RenderCheckerBoard(mesh IMesh, oddColor IPalette, evenColor IPalette)
+
+	// RenderTexture renders a texture whose upper-left corner is
+	// at local (x,y) with local dimensions (w,h). The current transform's
+	// rotation and scale are applied and the texture is tinted using
+	// the current draw color.
+	RenderTexture(texture *sdl.Texture, x, y, w, h float64)
 }
@@ -0,0 +1,37 @@
+package api
+
+// IState is a state of an IStateMachine. The owner is the node the
+// machine drives.
+type IState interface {
+	Name() string
+
+	Enter(owner INode)
+	// Update runs each update, dt is in milliseconds.
+	Update(owner INode, dt float64)
+	Exit(owner INode)
+}
+
+// IStateMachine is a finite state machine that changes state when a
+// transition's guard passes.
+type IStateMachine interface {
+	IDescriber
+
+	AddState(state IState)
+	// AddTransition moves from the state named "from" to the state
+	// named "to" when guard returns true. A "from" of "" means any
+	// other state.
+	AddTransition(from, to string, guard func() bool)
+
+	// Start enters the named state without exiting any other.
+	Start(name string)
+	// Change moves to the named state regardless of guards.
+	Change(name string) bool
+
+	Current() IState
+	Previous() IState
+	// Elapsed is the time, in milliseconds, spent in the current state.
+	Elapsed() float64
+
+	// Update checks transitions and then updates the current state.
+	Update(dt float64)
+}
@@ -0,0 +1,24 @@
+package api
+
+// ISteeringAgent is something moved by steering behaviours, for
+// example, a node or a Box2D body. Its velocity's max magnitude is
+// its max speed.
+type ISteeringAgent interface {
+	Position() IPoint
+	Velocity() IVelocity
+
+	// MaxForce limits the steering force applied each update.
+	MaxForce() float64
+	// Radius is the agent's size when avoiding obstacles.
+	Radius() float64
+
+	// ApplyForce accelerates the agent for dt seconds.
+	ApplyForce(force IVector, dt float64)
+}
+
+// ISteeringBehavior computes a steering force for an agent
+type ISteeringBehavior interface {
+	// Steer sets force to the behaviour's steering force. A zero force
+	// means the behaviour has nothing to do.
+	Steer(agent ISteeringAgent, force IVector)
+}
@@ -0,0 +1,10 @@
+package api
+
+const (
+	// TextAlignLeft aligns each line's left edge with the node's origin
+	TextAlignLeft = 0
+	// TextAlignCenter centers each line about the node's origin
+	TextAlignCenter = 1
+	// TextAlignRight aligns each line's right edge with the node's origin
+	TextAlignRight = 2
+)
@@ -0,0 +1,33 @@
+package api
+
+// ITimelineListener receives a timeline's markers as they are passed
+type ITimelineListener interface {
+	MarkerReached(timeline ITimeline, marker string)
+}
+
+// ITimeline animates node properties using tracks of keyframes. Each
+// track animates one property, for example, "position", of a node
+// found by a path relative to the node the timeline is bound to.
+type ITimeline interface {
+	// Bind resolves the tracks' nodes relative to a node
+	Bind(root INode)
+
+	// Play starts the timeline from the beginning. Keyframes without
+	// values take the property's value at this point.
+	Play()
+	Stop()
+	IsPlaying() bool
+
+	SetLoop(loop bool)
+
+	// Update advances a playing timeline by dt milliseconds
+	Update(dt float64)
+
+	// Seek applies the timeline's state at a time, in milliseconds,
+	// without passing markers.
+	Seek(time float64)
+	Time() float64
+	Duration() float64
+
+	SetListener(listener ITimelineListener)
+}
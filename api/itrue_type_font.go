@@ -0,0 +1,33 @@
+package api
+
+import "github.com/veandco/go-sdl2/sdl"
+
+// ITrueTypeFont is a TTF/OTF font rendered through SDL_ttf.
+// Glyphs are rasterized on demand and cached as textures, which means
+// the font can only be queried from the render thread once the
+// engine has been configured.
+type ITrueTypeFont interface {
+	// Initialize records the font file and point size. The font file
+	// isn't opened until the first query.
+	Initialize(fontFile string, size int, relativePath string)
+
+	// Destroy releases the cached glyph textures and closes the font.
+	Destroy()
+
+	// Height is the maximum pixel height of all glyphs
+	Height() int
+	// Ascent is the offset from the top of a line to the baseline
+	Ascent() int
+	// LineSkip is the recommended spacing between lines
+	LineSkip() int
+
+	// Advance returns the horizontal advance of a glyph
+	Advance(char rune) int
+
+	// Kerning returns the adjustment applied between "prev" and "char"
+	Kerning(prev, char rune) int
+
+	// Glyph returns the cached texture for the character along with
+	// the texture's dimensions.
+	Glyph(char rune) (texture *sdl.Texture, width, height int)
+}
@@ -17,6 +17,25 @@ const (
 	EquationExpo = 1
 	// EquationQuad Quadratic equations
 	EquationQuad = 2
+	// EquationSine Sinusoidal equations
+	EquationSine = 3
+	// EquationCubic Cubic equations
+	EquationCubic = 4
+	// EquationQuart Quartic equations
+	EquationQuart = 5
+	// EquationQuint Quintic equations
+	EquationQuint = 6
+	// EquationCirc Circular equations
+	EquationCirc = 7
+	// EquationBack equations overshoot
+	EquationBack = 8
+	// EquationElastic equations oscillate like a spring
+	EquationElastic = 9
+	// EquationBounce equations bounce
+	EquationBounce = 10
+
+	// RepeatForever repeats a tween until it is reset
+	RepeatForever = -1
 )
 
 // Compute computes time
@@ -33,5 +52,13 @@ type ITween interface {
 
 	Elapsed() float64
 
+	// SetDelay sets how long to wait before starting, in milliseconds
+	SetDelay(delay float64)
+	// SetRepeat sets how many times the tween plays again after the
+	// first time. RepeatForever never finishes.
+	SetRepeat(count int)
+	// SetYoyo plays every other repeat backwards
+	SetYoyo(yoyo bool)
+
 	Reset()
 }
@@ -8,6 +8,21 @@ type IVectorFont interface {
 	VerticalOffset() float64
 	Scale() float64
 
-	// Glyph returns an array of vertices that matches the character
-	Glyph(char byte) []float64
+	// Glyph returns an array of vertices that matches the character.
+	// A fallback glyph is returned if the character isn't in the font.
+	Glyph(char rune) []float64
+
+	// HasGlyph indicates if the font defines the character
+	HasGlyph(char rune) bool
+
+	// Extents returns the horizontal extents of a glyph. Empty glyphs,
+	// for example space, return zero extents.
+	Extents(char rune) (minX, maxX float64)
+
+	// Advance returns the proportional advance width of a glyph which is
+	// the glyph's width plus the font's inter-glyph spacing.
+	Advance(char rune) float64
+
+	// Kerning returns the adjustment applied between "prev" and "char"
+	Kerning(prev, char rune) float64
 }
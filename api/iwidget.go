@@ -0,0 +1,58 @@
+package api
+
+const (
+	// AnchorTopLeft pins a widget to the upper-left of its container
+	AnchorTopLeft = iota
+	// AnchorTop pins a widget to the top-center
+	AnchorTop
+	// AnchorTopRight pins a widget to the upper-right
+	AnchorTopRight
+	// AnchorLeft pins a widget to the middle-left
+	AnchorLeft
+	// AnchorCenter centers a widget
+	AnchorCenter
+	// AnchorRight pins a widget to the middle-right
+	AnchorRight
+	// AnchorBottomLeft pins a widget to the lower-left
+	AnchorBottomLeft
+	// AnchorBottom pins a widget to the bottom-center
+	AnchorBottom
+	// AnchorBottomRight pins a widget to the lower-right
+	AnchorBottomRight
+)
+
+// WidgetAction is called when a widget is activated or its value changes.
+type WidgetAction func(widget IWidget)
+
+// IWidget is a UI control node. A widget's local origin is its
+// upper-left corner and it extends to the right and downward.
+type IWidget interface {
+	INode
+
+	Size() (w, h float64)
+	SetSize(w, h float64)
+
+	// PreferredSize is the size the widget would like a layout to give it.
+	PreferredSize() (w, h float64)
+
+	IsEnabled() bool
+	SetEnabled(bool)
+
+	// IsFocusable indicates if the widget takes part in keyboard navigation
+	IsFocusable() bool
+	HasFocus() bool
+	SetFocus(bool)
+
+	// PointInside checks if a local-space point is within the widget
+	PointInside(p IPoint) bool
+}
+
+// ILayout arranges a container's child widgets
+type ILayout interface {
+	// Measure returns the content size needed by the children.
+	Measure(children []IWidget) (w, h float64)
+
+	// Arrange positions, and may resize, the children within a content
+	// area whose upper-left corner is (x,y).
+	Arrange(x, y, width, height float64, children []IWidget)
+}
@@ -75,4 +75,7 @@ type IWorld interface {
 	RasterFont() IRasterFont
 
 	WorkingPath() string
+
+	// NodeRegistry allocates node ids and tracks the running nodes
+	NodeRegistry() INodeRegistry
 }
@@ -10,7 +10,8 @@ const (
 	CrossStateEntered = 1
 	// CrossStateExited Object has exit zone.
 	CrossStateExited = 2
-	// CrossStateInside Object is currently inside
+	// CrossStateInside Object is currently inside. A ZoneManager sends
+	// it for every check between entering and exiting.
 	CrossStateInside = 3
 	// CrossStateOutside Object hasn't entered yet
 	CrossStateOutside = 4
@@ -36,9 +37,18 @@ const (
 	ZoneActionOutward = 2
 )
 
-// IZone an area with two regions: inner and outer
+// IZone an area with two regions: inner and outer. An object enters
+// when it reaches the inner region and exits only when it leaves the
+// outer region.
 type IZone interface {
+	// Update tracks a single object and returns its CrossState and
+	// whether it changed.
 	Update(position IPoint) (int, bool)
 
 	State() int
+
+	// PointInside returns the region a point is in:
+	// ZoneStateEnteredInner, ZoneStateEnteredOuter or
+	// ZoneStateObjectIsOutside.
+	PointInside(point IPoint) int
 }
@@ -2,5 +2,7 @@ package api
 
 // IZoneListener is for objects wanting to be notified of Zone events
 type IZoneListener interface {
-	Notify(state, id int)
+	// Notify receives a CrossState for an object and a zone, for
+	// example, CrossStateEntered.
+	Notify(state, zoneID, objectID int)
 }
@@ -0,0 +1,204 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// glyph is a character and its line segments. Each segment is
+// four values: x1 y1 x2 y2
+type glyph struct {
+	char     rune
+	segments [][4]float64
+	line     int // Line the glyph began on, used for reporting.
+}
+
+// kern is an optional pair adjustment: "kern AV -0.15"
+type kern struct {
+	pair   [2]rune
+	adjust float64
+}
+
+// font mirrors the segment format loaded by rendering.NewVectorFont.
+type font struct {
+	horizontalOffset float64
+	verticalOffset   float64
+
+	glyphs  []*glyph
+	kerning []kern
+}
+
+func newFont() *font {
+	o := new(font)
+	o.horizontalOffset = 1.3
+	o.verticalOffset = 1.2
+	return o
+}
+
+func (f *font) find(char rune) *glyph {
+	for _, g := range f.glyphs {
+		if g.char == char {
+			return g
+		}
+	}
+
+	return nil
+}
+
+// readHeader parses the "horizontalOffset" and "verticalOffset" lines
+// shared by both the segment and grid-map formats.
+func readHeader(scanner *bufio.Scanner, f *font, lineNo *int) error {
+	for _, name := range []string{"horizontalOffset", "verticalOffset"} {
+		if !scanner.Scan() {
+			return fmt.Errorf("line %d: missing %s", *lineNo+1, name)
+		}
+		*lineNo++
+
+		ele := strings.Fields(scanner.Text())
+		if len(ele) != 2 || ele[0] != name {
+			return fmt.Errorf("line %d: expected '%s <value>'", *lineNo, name)
+		}
+
+		v, err := strconv.ParseFloat(ele[1], 64)
+		if err != nil {
+			return fmt.Errorf("line %d: %s", *lineNo, err)
+		}
+
+		if name == "horizontalOffset" {
+			f.horizontalOffset = v
+		} else {
+			f.verticalOffset = v
+		}
+	}
+
+	return nil
+}
+
+// readSegmentFont parses the segment format, for example, vector_font.data
+func readSegmentFont(path string) (*font, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	f := newFont()
+	lineNo := 0
+
+	if err := readHeader(scanner, f, &lineNo); err != nil {
+		return nil, err
+	}
+
+	var g *glyph
+
+	for scanner.Scan() {
+		lineNo++
+		line := scanner.Text()
+
+		switch {
+		case len(line) == 0:
+			continue
+		case g == nil && strings.HasPrefix(line, "kern "):
+			ele := strings.Fields(line)
+			if len(ele) != 3 || utf8.RuneCountInString(ele[1]) != 2 {
+				return nil, fmt.Errorf("line %d: expected 'kern <pair> <value>'", lineNo)
+			}
+			pair := []rune(ele[1])
+			k, err := strconv.ParseFloat(ele[2], 64)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %s", lineNo, err)
+			}
+			f.kerning = append(f.kerning, kern{pair: [2]rune{pair[0], pair[1]}, adjust: k})
+		case line == "||":
+			if g == nil {
+				return nil, fmt.Errorf("line %d: '||' without a glyph", lineNo)
+			}
+			f.glyphs = append(f.glyphs, g)
+			g = nil
+		case utf8.RuneCountInString(line) == 1:
+			if g != nil {
+				return nil, fmt.Errorf("line %d: glyph '%c' isn't terminated with '||'", g.line, g.char)
+			}
+			c, _ := utf8.DecodeRuneInString(line)
+			g = &glyph{char: c, line: lineNo}
+		default:
+			if g == nil {
+				return nil, fmt.Errorf("line %d: segment outside of a glyph", lineNo)
+			}
+			ele := strings.Fields(line)
+			if len(ele) != 4 {
+				return nil, fmt.Errorf("line %d: expected 4 values, found %d", lineNo, len(ele))
+			}
+			var seg [4]float64
+			for i, e := range ele {
+				seg[i], err = strconv.ParseFloat(e, 64)
+				if err != nil {
+					return nil, fmt.Errorf("line %d: %s", lineNo, err)
+				}
+			}
+			g.segments = append(g.segments, seg)
+		}
+	}
+
+	if g != nil {
+		return nil, fmt.Errorf("line %d: glyph '%c' isn't terminated with '||'", g.line, g.char)
+	}
+
+	return f, scanner.Err()
+}
+
+// write emits the segment format.
+func (f *font) write(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+
+	fmt.Fprintf(bw, "horizontalOffset %s\n", formatFloat(f.horizontalOffset))
+	fmt.Fprintf(bw, "verticalOffset %s\n\n", formatFloat(f.verticalOffset))
+
+	for _, k := range f.kerning {
+		fmt.Fprintf(bw, "kern %c%c %s\n", k.pair[0], k.pair[1], formatFloat(k.adjust))
+	}
+	if len(f.kerning) > 0 {
+		fmt.Fprintln(bw)
+	}
+
+	for _, g := range f.glyphs {
+		fmt.Fprintf(bw, "%c\n", g.char)
+		for _, s := range g.segments {
+			fmt.Fprintf(bw, "%s %s %s %s\n", formatFloat(s[0]), formatFloat(s[1]), formatFloat(s[2]), formatFloat(s[3]))
+		}
+		fmt.Fprintln(bw, "||")
+	}
+
+	return bw.Flush()
+}
+
+func formatFloat(v float64) string {
+	s := strconv.FormatFloat(v, 'f', 3, 64)
+	s = strings.TrimRight(s, "0")
+	s = strings.TrimSuffix(s, ".")
+	if s == "-0" || s == "" {
+		s = "0"
+	}
+	return s
+}
+
+// writeFont writes to the path or stdout if path is empty.
+func writeFont(f *font, path string) error {
+	if path == "" {
+		return f.write(os.Stdout)
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return f.write(file)
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// The grid-map format (vector_map_font.data) draws each glyph on a
+// character grid terminated by "+++". A cell is either "0" or a label
+// made of a stroke letter and an order number, for example, "A1".
+// Cells sharing a stroke letter are connected in numeric order which
+// forms a polyline:
+//
+//   0  0  A2 0  0
+//   0  B1 0  B2 0
+//   A1 0  0  0  A3
+//
+// The grid's left/right edges map to x = -0.5/0.5 and the top/bottom
+// rows map to y = -1.0/0.0 which matches the segment format's glyph space.
+
+type gridPoint struct {
+	order int
+	x, y  float64
+}
+
+// readGridMapFont parses the grid-map format into segments.
+func readGridMapFont(path string) (*font, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	f := newFont()
+	lineNo := 0
+
+	if err := readHeader(scanner, f, &lineNo); err != nil {
+		return nil, err
+	}
+
+	var char rune
+	var start int
+	var rows [][]string
+	inGlyph := false
+
+	for scanner.Scan() {
+		lineNo++
+		line := strings.TrimSpace(scanner.Text())
+
+		switch {
+		case !inGlyph && len(line) == 0:
+			continue
+		case !inGlyph:
+			if utf8.RuneCountInString(line) != 1 {
+				return nil, fmt.Errorf("line %d: expected a glyph character, found '%s'", lineNo, line)
+			}
+			char, _ = utf8.DecodeRuneInString(line)
+			start = lineNo
+			rows = nil
+			inGlyph = true
+		case line == "+++":
+			g, err := compileGrid(char, rows, start)
+			if err != nil {
+				return nil, err
+			}
+			f.glyphs = append(f.glyphs, g)
+			inGlyph = false
+		default:
+			rows = append(rows, strings.Fields(line))
+		}
+	}
+
+	if inGlyph {
+		return nil, fmt.Errorf("line %d: glyph '%c' isn't terminated with '+++'", start, char)
+	}
+
+	return f, scanner.Err()
+}
+
+func compileGrid(char rune, rows [][]string, line int) (*glyph, error) {
+	if len(rows) < 2 {
+		return nil, fmt.Errorf("line %d: glyph '%c' grid needs at least 2 rows", line, char)
+	}
+
+	cols := len(rows[0])
+	if cols < 2 {
+		return nil, fmt.Errorf("line %d: glyph '%c' grid needs at least 2 columns", line, char)
+	}
+
+	strokes := map[string][]gridPoint{}
+
+	for r, row := range rows {
+		if len(row) != cols {
+			return nil, fmt.Errorf("line %d: glyph '%c' row %d has %d columns, expected %d",
+				line+r+1, char, r, len(row), cols)
+		}
+
+		for c, cell := range row {
+			if cell == "0" {
+				continue
+			}
+
+			stroke := strings.TrimRight(cell, "0123456789")
+			order, err := strconv.Atoi(cell[len(stroke):])
+			if stroke == "" || err != nil {
+				return nil, fmt.Errorf("line %d: glyph '%c' has invalid cell '%s'", line+r+1, char, cell)
+			}
+
+			strokes[stroke] = append(strokes[stroke], gridPoint{
+				order: order,
+				x:     float64(c)/float64(cols-1) - 0.5,
+				y:     float64(r)/float64(len(rows)-1) - 1.0,
+			})
+		}
+	}
+
+	names := make([]string, 0, len(strokes))
+	for name := range strokes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	g := &glyph{char: char, line: line}
+
+	for _, name := range names {
+		points := strokes[name]
+		sort.Slice(points, func(i, j int) bool { return points[i].order < points[j].order })
+
+		for i := 1; i < len(points); i++ {
+			if points[i].order == points[i-1].order {
+				return nil, fmt.Errorf("line %d: glyph '%c' stroke %s has duplicate order %d",
+					line, char, name, points[i].order)
+			}
+			g.segments = append(g.segments, [4]float64{points[i-1].x, points[i-1].y, points[i].x, points[i].y})
+		}
+	}
+
+	return g, nil
+}
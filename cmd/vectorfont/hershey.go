@@ -0,0 +1,99 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Hershey fonts (.jhf) store one glyph per record:
+//
+//   columns 0-4  glyph number
+//   columns 5-7  vertex count, including the left/right extent pair
+//   columns 8-   vertex pairs encoded as characters relative to 'R'
+//
+// A " R" pair lifts the pen. Long records wrap onto continuation lines.
+// The glyphs of the common .jhf files are stored in ASCII order starting
+// with the space character.
+
+// hersheyOptions maps Hershey units into glyph space.
+type hersheyOptions struct {
+	firstChar rune
+	baseline  float64 // Hershey y of the baseline
+	height    float64 // Hershey units from cap line to baseline
+}
+
+func readHersheyFont(path string, opts hersheyOptions) (*font, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	f := newFont()
+	char := opts.firstChar
+	lineNo := 0
+
+	for scanner.Scan() {
+		lineNo++
+		record := scanner.Text()
+
+		if strings.TrimSpace(record) == "" {
+			continue
+		}
+
+		if len(record) < 8 {
+			return nil, fmt.Errorf("line %d: record is too short", lineNo)
+		}
+
+		count, err := strconv.Atoi(strings.TrimSpace(record[5:8]))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid vertex count: %s", lineNo, err)
+		}
+
+		data := record[8:]
+		start := lineNo
+		for len(data) < count*2 && scanner.Scan() {
+			lineNo++
+			data += scanner.Text()
+		}
+
+		if len(data) < count*2 {
+			return nil, fmt.Errorf("line %d: expected %d vertices", start, count)
+		}
+
+		g := &glyph{char: char, line: start}
+		char++
+
+		var px, py float64
+		penDown := false
+
+		// Skip the left/right extent pair.
+		for i := 1; i < count; i++ {
+			cx := data[i*2]
+			cy := data[i*2+1]
+
+			if cx == ' ' && cy == 'R' {
+				penDown = false
+				continue
+			}
+
+			x := float64(int(cx)-int('R')) / opts.height
+			y := (float64(int(cy)-int('R')) - opts.baseline) / opts.height
+
+			if penDown {
+				g.segments = append(g.segments, [4]float64{px, py, x, y})
+			}
+
+			px, py = x, y
+			penDown = true
+		}
+
+		f.glyphs = append(f.glyphs, g)
+	}
+
+	return f, scanner.Err()
+}
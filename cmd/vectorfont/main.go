@@ -0,0 +1,158 @@
+// Command vectorfont compiles, validates, previews and imports the
+// vector fonts used by rendering.NewVectorFont.
+//
+// Usage:
+//
+//	vectorfont compile  -in vector_map_font.data -out vector_font.data
+//	vectorfont validate -in vector_font.data
+//	vectorfont specimen -in vector_font.data -out specimen.png
+//	vectorfont hershey  -in futural.jhf -out vector_font.data
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+)
+
+// printable is every printable ASCII character except space.
+const printable = "!\"#$%&'()*+,-./0123456789:;<=>?@ABCDEFGHIJKLMNOPQRSTUVWXYZ[\\]^_`abcdefghijklmnopqrstuvwxyz{|}~"
+
+func main() {
+	if len(os.Args) < 2 {
+		usage()
+		os.Exit(2)
+	}
+
+	var err error
+
+	switch os.Args[1] {
+	case "compile":
+		err = compileCmd(os.Args[2:])
+	case "validate":
+		err = validateCmd(os.Args[2:])
+	case "specimen":
+		err = specimenCmd(os.Args[2:])
+	case "hershey":
+		err = hersheyCmd(os.Args[2:])
+	default:
+		usage()
+		os.Exit(2)
+	}
+
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "vectorfont:", err)
+		os.Exit(1)
+	}
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "usage: vectorfont <compile|validate|specimen|hershey> [flags]")
+	fmt.Fprintln(os.Stderr, "  compile   converts the grid-map format into the segment format")
+	fmt.Fprintln(os.Stderr, "  validate  checks glyph bounds and missing characters")
+	fmt.Fprintln(os.Stderr, "  specimen  renders every glyph into a PNG")
+	fmt.Fprintln(os.Stderr, "  hershey   imports a Hershey (.jhf) font into the segment format")
+}
+
+func compileCmd(args []string) error {
+	fs := flag.NewFlagSet("compile", flag.ExitOnError)
+	in := fs.String("in", "", "grid-map font file")
+	out := fs.String("out", "", "segment font file (default stdout)")
+	fs.Parse(args)
+
+	if *in == "" {
+		return fmt.Errorf("compile: -in is required")
+	}
+
+	f, err := readGridMapFont(*in)
+	if err != nil {
+		return err
+	}
+
+	return writeFont(f, *out)
+}
+
+func validateCmd(args []string) error {
+	fs := flag.NewFlagSet("validate", flag.ExitOnError)
+	in := fs.String("in", "", "segment font file")
+	grid := fs.Bool("grid", false, "the input is in the grid-map format")
+	required := fs.String("chars", printable, "characters the font must contain")
+	minX := fs.Float64("minx", -0.75, "minimum x of glyph space")
+	maxX := fs.Float64("maxx", 0.75, "maximum x of glyph space")
+	minY := fs.Float64("miny", -1.25, "minimum y of glyph space")
+	maxY := fs.Float64("maxy", 0.5, "maximum y of glyph space")
+	fs.Parse(args)
+
+	f, err := readInput(*in, *grid)
+	if err != nil {
+		return err
+	}
+
+	problems := validate(f, bounds{*minX, *maxX, *minY, *maxY}, *required, os.Stdout)
+	if problems > 0 {
+		return fmt.Errorf("validate: %d problem(s) found", problems)
+	}
+
+	fmt.Printf("%d glyphs OK\n", len(f.glyphs))
+
+	return nil
+}
+
+func specimenCmd(args []string) error {
+	fs := flag.NewFlagSet("specimen", flag.ExitOnError)
+	in := fs.String("in", "", "segment font file")
+	grid := fs.Bool("grid", false, "the input is in the grid-map format")
+	out := fs.String("out", "specimen.png", "PNG output file")
+	cell := fs.Int("cell", 64, "cell size in pixels")
+	columns := fs.Int("columns", 16, "glyphs per row")
+	fs.Parse(args)
+
+	f, err := readInput(*in, *grid)
+	if err != nil {
+		return err
+	}
+
+	return renderSpecimen(f, *out, *cell, *columns)
+}
+
+func hersheyCmd(args []string) error {
+	fs := flag.NewFlagSet("hershey", flag.ExitOnError)
+	in := fs.String("in", "", "Hershey .jhf font file")
+	out := fs.String("out", "", "segment font file (default stdout)")
+	first := fs.Int("first", ' ', "character code of the first glyph")
+	baseline := fs.Float64("baseline", 9.0, "Hershey y coordinate of the baseline")
+	height := fs.Float64("height", 21.0, "Hershey units from cap line to baseline")
+	hOffset := fs.Float64("hoffset", 1.3, "horizontal offset written to the header")
+	vOffset := fs.Float64("voffset", 1.2, "vertical offset written to the header")
+	fs.Parse(args)
+
+	if *in == "" {
+		return fmt.Errorf("hershey: -in is required")
+	}
+
+	f, err := readHersheyFont(*in, hersheyOptions{
+		firstChar: rune(*first),
+		baseline:  *baseline,
+		height:    *height,
+	})
+	if err != nil {
+		return err
+	}
+
+	f.horizontalOffset = *hOffset
+	f.verticalOffset = *vOffset
+
+	return writeFont(f, *out)
+}
+
+func readInput(path string, grid bool) (*font, error) {
+	if path == "" {
+		return nil, fmt.Errorf("-in is required")
+	}
+
+	if grid {
+		return readGridMapFont(path)
+	}
+
+	return readSegmentFont(path)
+}
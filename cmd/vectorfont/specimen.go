@@ -0,0 +1,78 @@
+package main
+
+import (
+	"image"
+	"image/color"
+	"image/png"
+	"math"
+	"os"
+)
+
+var (
+	specimenBackground = color.RGBA{R: 40, G: 40, B: 40, A: 255}
+	specimenGuide      = color.RGBA{R: 70, G: 70, B: 90, A: 255}
+	specimenInk        = color.RGBA{R: 255, G: 255, B: 255, A: 255}
+)
+
+// renderSpecimen draws every glyph into a grid of cells and writes a PNG.
+// Each cell shows the glyph's baseline and cap line as guides.
+func renderSpecimen(f *font, path string, cellSize, columns int) error {
+	count := len(f.glyphs)
+	rowCount := (count + columns - 1) / columns
+	if rowCount == 0 {
+		rowCount = 1
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, columns*cellSize, rowCount*cellSize))
+
+	for y := 0; y < img.Bounds().Dy(); y++ {
+		for x := 0; x < img.Bounds().Dx(); x++ {
+			img.SetRGBA(x, y, specimenBackground)
+		}
+	}
+
+	// Glyph space is roughly 1.0 high with the baseline at 0.0 so the
+	// glyph is scaled to fit leaving room for descenders.
+	scale := float64(cellSize) * 0.6
+	for i, g := range f.glyphs {
+		cx := float64((i%columns)*cellSize) + float64(cellSize)/2.0
+		baseline := float64((i/columns)*cellSize) + float64(cellSize)*0.75
+
+		left := cx - float64(cellSize)/2.0 + 2
+		right := cx + float64(cellSize)/2.0 - 2
+		drawLine(img, left, baseline, right, baseline, specimenGuide)
+		drawLine(img, left, baseline-scale, right, baseline-scale, specimenGuide)
+
+		for _, s := range g.segments {
+			drawLine(img,
+				cx+s[0]*scale, baseline+s[1]*scale,
+				cx+s[2]*scale, baseline+s[3]*scale,
+				specimenInk)
+		}
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	return png.Encode(file, img)
+}
+
+// drawLine is a simple DDA line rasterizer.
+func drawLine(img *image.RGBA, x1, y1, x2, y2 float64, c color.RGBA) {
+	dx := x2 - x1
+	dy := y2 - y1
+	steps := math.Max(math.Abs(dx), math.Abs(dy))
+
+	if steps == 0 {
+		img.SetRGBA(int(math.Round(x1)), int(math.Round(y1)), c)
+		return
+	}
+
+	for i := 0.0; i <= steps; i++ {
+		t := i / steps
+		img.SetRGBA(int(math.Round(x1+dx*t)), int(math.Round(y1+dy*t)), c)
+	}
+}
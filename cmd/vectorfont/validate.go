@@ -0,0 +1,62 @@
+package main
+
+import (
+	"fmt"
+	"io"
+)
+
+// bounds is the glyph-space box every vertex must fall within.
+type bounds struct {
+	minX, maxX float64
+	minY, maxY float64
+}
+
+// validate reports segments outside of the bounds, degenerate segments,
+// duplicate glyphs and any characters from "required" that are missing.
+// It returns the number of problems found.
+func validate(f *font, b bounds, required string, w io.Writer) int {
+	problems := 0
+	seen := map[rune]int{}
+
+	for _, g := range f.glyphs {
+		if line, ok := seen[g.char]; ok {
+			fmt.Fprintf(w, "line %d: glyph '%c' duplicates the glyph on line %d\n", g.line, g.char, line)
+			problems++
+		}
+		seen[g.char] = g.line
+
+		for i, s := range g.segments {
+			if s[0] == s[2] && s[1] == s[3] {
+				fmt.Fprintf(w, "glyph '%c' segment %d is zero length\n", g.char, i)
+				problems++
+			}
+
+			for v := 0; v < 4; v += 2 {
+				x, y := s[v], s[v+1]
+				if x < b.minX || x > b.maxX || y < b.minY || y > b.maxY {
+					fmt.Fprintf(w, "glyph '%c' segment %d vertex (%s, %s) is out of bounds\n",
+						g.char, i, formatFloat(x), formatFloat(y))
+					problems++
+				}
+			}
+		}
+	}
+
+	for _, k := range f.kerning {
+		for _, c := range k.pair {
+			if _, ok := seen[c]; !ok {
+				fmt.Fprintf(w, "kerning pair '%c%c' references missing glyph '%c'\n", k.pair[0], k.pair[1], c)
+				problems++
+			}
+		}
+	}
+
+	for _, c := range required {
+		if _, ok := seen[c]; !ok {
+			fmt.Fprintf(w, "missing glyph '%c' (U+%04X)\n", c, c)
+			problems++
+		}
+	}
+
+	return problems
+}
@@ -0,0 +1,163 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestCompileGrid(t *testing.T) {
+	tests := []struct {
+		name     string
+		grid     string
+		segments [][4]float64
+		err      string
+	}{
+		{
+			name: "two strokes",
+			grid: "A1 0 A2\n0 B1 0\nB2 0 0",
+			segments: [][4]float64{
+				{-0.5, -1.0, 0.5, -1.0},
+				{0.0, -0.5, -0.5, 0.0},
+			},
+		},
+		{
+			name:     "stroke order",
+			grid:     "A2 A1\nA3 0",
+			segments: [][4]float64{{0.5, -1.0, -0.5, -1.0}, {-0.5, -1.0, -0.5, 0.0}},
+		},
+		{name: "ragged row", grid: "A1 0\nA2", err: "row 1 has 1 columns"},
+		{name: "bad cell", grid: "A1 0\n12 A2", err: "invalid cell '12'"},
+		{name: "duplicate order", grid: "A1 A1\n0 0", err: "duplicate order 1"},
+		{name: "one row", grid: "A1 A2", err: "at least 2 rows"},
+	}
+
+	for _, test := range tests {
+		rows := [][]string{}
+		for _, line := range strings.Split(test.grid, "\n") {
+			rows = append(rows, strings.Fields(line))
+		}
+
+		g, err := compileGrid('X', rows, 1)
+
+		if test.err != "" {
+			if err == nil || !strings.Contains(err.Error(), test.err) {
+				t.Fatalf("%s: expected error '%s', got %v", test.name, test.err, err)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Fatalf("%s: %s", test.name, err)
+		}
+
+		if len(g.segments) != len(test.segments) {
+			t.Fatalf("%s: expected %v, got %v", test.name, test.segments, g.segments)
+		}
+		for i, s := range test.segments {
+			if g.segments[i] != s {
+				t.Fatalf("%s: segment %d expected %v, got %v", test.name, i, s, g.segments[i])
+			}
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	b := bounds{minX: -0.5, maxX: 0.5, minY: -1.0, maxY: 0.0}
+
+	tests := []struct {
+		name     string
+		glyphs   []*glyph
+		required string
+		problems int
+		report   string
+	}{
+		{
+			name:   "in bounds",
+			glyphs: []*glyph{{char: 'A', segments: [][4]float64{{-0.5, 0.0, 0.0, -1.0}}}},
+		},
+		{
+			name:     "out of bounds",
+			glyphs:   []*glyph{{char: 'A', segments: [][4]float64{{-0.5, 0.0, 0.75, -1.0}}}},
+			problems: 1,
+			report:   "vertex (0.75, -1) is out of bounds",
+		},
+		{
+			name:     "zero length",
+			glyphs:   []*glyph{{char: 'A', segments: [][4]float64{{0.0, 0.0, 0.0, 0.0}}}},
+			problems: 1,
+			report:   "zero length",
+		},
+		{
+			name:     "missing",
+			glyphs:   []*glyph{{char: 'A'}},
+			required: "AB",
+			problems: 1,
+			report:   "missing glyph 'B'",
+		},
+	}
+
+	for _, test := range tests {
+		f := newFont()
+		f.glyphs = test.glyphs
+
+		var w bytes.Buffer
+		problems := validate(f, b, test.required, &w)
+
+		if problems != test.problems || !strings.Contains(w.String(), test.report) {
+			t.Fatalf("%s: expected %d problems reporting '%s', got %d: %s",
+				test.name, test.problems, test.report, problems, w.String())
+		}
+	}
+}
+
+func TestReadHersheyFont(t *testing.T) {
+	dir, err := ioutil.TempDir("", "hershey")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// A vertical stroke, a pen lift, then a short tick: the extent pair, three
+	// vertices, " R" and two more.
+	path := filepath.Join(dir, "test.jhf")
+	record := "    1  7MWRFRLRR RRPSP\n"
+	if err := ioutil.WriteFile(path, []byte(record), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := readHersheyFont(path, hersheyOptions{firstChar: 'I', baseline: 0.0, height: 12.0})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(f.glyphs) != 1 || f.glyphs[0].char != 'I' {
+		t.Fatalf("Expected glyph 'I', got %v", f.glyphs)
+	}
+
+	expected := [][4]float64{
+		{0.0, -1.0, 0.0, -0.5},
+		{0.0, -0.5, 0.0, 0.0},
+		{0.0, -2.0 / 12.0, 1.0 / 12.0, -2.0 / 12.0},
+	}
+	segments := f.glyphs[0].segments
+	if len(segments) != len(expected) {
+		t.Fatalf("Expected %v, got %v", expected, segments)
+	}
+	for i, s := range expected {
+		if segments[i] != s {
+			t.Fatalf("Segment %d expected %v, got %v", i, s, segments[i])
+		}
+	}
+
+	// A record whose vertices are cut short
+	if err := ioutil.WriteFile(path, []byte("    1  7MWRF\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := readHersheyFont(path, hersheyOptions{firstChar: 'I', height: 12.0}); err == nil {
+		t.Fatal("Expected an error for a short record")
+	}
+}
@@ -0,0 +1,34 @@
+package behavior
+
+import "github.com/wdevore/RangerGo/api"
+
+// behavior is embedded by every behavior and remembers the status of
+// its last tick for Describe.
+type behavior struct {
+	name   string
+	status int
+}
+
+func (b *behavior) Name() string {
+	return b.name
+}
+
+func (b *behavior) last() int {
+	return b.status
+}
+
+func (b *behavior) done(status int) int {
+	b.status = status
+	return status
+}
+
+// ticked is implemented by every behavior in this package.
+type ticked interface {
+	last() int
+}
+
+// parent is implemented by composites and decorators. active returns
+// the running child, or nil.
+type parent interface {
+	active() api.IBehavior
+}
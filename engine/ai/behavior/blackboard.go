@@ -0,0 +1,34 @@
+package behavior
+
+// Blackboard is a map backed api.IBlackboard
+type Blackboard struct {
+	values map[string]interface{}
+}
+
+// NewBlackboard constructs an empty blackboard
+func NewBlackboard() *Blackboard {
+	o := new(Blackboard)
+	o.values = map[string]interface{}{}
+	return o
+}
+
+// Set stores a value
+func (b *Blackboard) Set(key string, value interface{}) {
+	b.values[key] = value
+}
+
+// Get returns a value or nil
+func (b *Blackboard) Get(key string) interface{} {
+	return b.values[key]
+}
+
+// Has returns true if the key has a value
+func (b *Blackboard) Has(key string) bool {
+	_, ok := b.values[key]
+	return ok
+}
+
+// Delete removes a value
+func (b *Blackboard) Delete(key string) {
+	delete(b.values, key)
+}
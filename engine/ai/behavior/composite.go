@@ -0,0 +1,70 @@
+package behavior
+
+import "github.com/wdevore/RangerGo/api"
+
+// composite ticks its children in order, moving to the next child
+// while they finish with "next".
+type composite struct {
+	behavior
+
+	children []api.IBehavior
+	current  int
+	next     int
+}
+
+// Sequence succeeds when every child succeeds, in order, and fails as
+// soon as one fails.
+func Sequence(name string, children ...api.IBehavior) api.IBehavior {
+	o := new(composite)
+	o.name = name
+	o.children = children
+	o.next = api.BehaviorSuccess
+	return o
+}
+
+// Selector succeeds as soon as a child succeeds, trying them in order,
+// and fails when every child fails.
+func Selector(name string, children ...api.IBehavior) api.IBehavior {
+	o := new(composite)
+	o.name = name
+	o.children = children
+	o.next = api.BehaviorFailure
+	return o
+}
+
+// Tick resumes the running child
+func (c *composite) Tick(board api.IBlackboard, dt float64) int {
+	for c.current < len(c.children) {
+		status := c.children[c.current].Tick(board, dt)
+
+		if status == api.BehaviorRunning {
+			return c.done(status)
+		}
+
+		if status != c.next {
+			c.current = 0
+			return c.done(status)
+		}
+
+		c.current++
+	}
+
+	c.current = 0
+	return c.done(c.next)
+}
+
+// Reset resets the composite and its children
+func (c *composite) Reset() {
+	c.current = 0
+	for _, child := range c.children {
+		child.Reset()
+	}
+}
+
+func (c *composite) active() api.IBehavior {
+	if c.status != api.BehaviorRunning {
+		return nil
+	}
+
+	return c.children[c.current]
+}
@@ -0,0 +1,113 @@
+package behavior
+
+import "github.com/wdevore/RangerGo/api"
+
+// decorator changes its child's status with "decorate". The child only
+// runs while decorate returns running.
+type decorator struct {
+	behavior
+
+	child    api.IBehavior
+	decorate func(status int) int
+}
+
+// Decorate wraps a child with a function mapping the child's status
+// to the decorator's.
+func Decorate(name string, child api.IBehavior, decorate func(status int) int) api.IBehavior {
+	o := new(decorator)
+	o.name = name
+	o.child = child
+	o.decorate = decorate
+	return o
+}
+
+// Invert swaps its child's success and failure.
+func Invert(child api.IBehavior) api.IBehavior {
+	return Decorate("Invert", child, func(status int) int {
+		switch status {
+		case api.BehaviorSuccess:
+			return api.BehaviorFailure
+		case api.BehaviorFailure:
+			return api.BehaviorSuccess
+		}
+		return status
+	})
+}
+
+// Succeed succeeds whenever its child finishes.
+func Succeed(child api.IBehavior) api.IBehavior {
+	return Decorate("Succeed", child, func(status int) int {
+		if status == api.BehaviorRunning {
+			return status
+		}
+		return api.BehaviorSuccess
+	})
+}
+
+// repeat is a decorator counting its child's runs
+type repeat struct {
+	decorator
+
+	count int
+	runs  int
+}
+
+// Repeat runs its child count times, or forever if count <= 0, and
+// fails if the child fails. The child finishes at most once per tick.
+func Repeat(count int, child api.IBehavior) api.IBehavior {
+	o := new(repeat)
+	o.name = "Repeat"
+	o.child = child
+	o.count = count
+	o.decorate = o.repeat
+	return o
+}
+
+func (r *repeat) repeat(status int) int {
+	switch status {
+	case api.BehaviorFailure:
+		r.runs = 0
+		return status
+	case api.BehaviorSuccess:
+		r.runs++
+		if r.count > 0 && r.runs >= r.count {
+			r.runs = 0
+			return status
+		}
+	}
+	return api.BehaviorRunning
+}
+
+// Reset clears the run count and resets the child
+func (r *repeat) Reset() {
+	r.runs = 0
+	r.decorator.Reset()
+}
+
+// UntilFail runs its child until it fails and then succeeds.
+func UntilFail(child api.IBehavior) api.IBehavior {
+	return Decorate("UntilFail", child, func(status int) int {
+		if status == api.BehaviorFailure {
+			return api.BehaviorSuccess
+		}
+		return api.BehaviorRunning
+	})
+}
+
+// Tick ticks the child
+func (d *decorator) Tick(board api.IBlackboard, dt float64) int {
+	return d.done(d.decorate(d.child.Tick(board, dt)))
+}
+
+// Reset resets the child
+func (d *decorator) Reset() {
+	d.child.Reset()
+}
+
+func (d *decorator) active() api.IBehavior {
+	if d.status != api.BehaviorRunning {
+		return nil
+	}
+
+	return d.child
+}
@@ -0,0 +1,65 @@
+package behavior
+
+import "github.com/wdevore/RangerGo/api"
+
+type action struct {
+	behavior
+
+	run func(board api.IBlackboard, dt float64) int
+}
+
+// Action is a leaf running a function that returns a status
+func Action(name string, run func(board api.IBlackboard, dt float64) int) api.IBehavior {
+	o := new(action)
+	o.name = name
+	o.run = run
+	return o
+}
+
+// Condition is a leaf that succeeds when test returns true and fails
+// otherwise.
+func Condition(name string, test func(board api.IBlackboard) bool) api.IBehavior {
+	return Action(name, func(board api.IBlackboard, dt float64) int {
+		if test(board) {
+			return api.BehaviorSuccess
+		}
+		return api.BehaviorFailure
+	})
+}
+
+func (a *action) Tick(board api.IBlackboard, dt float64) int {
+	return a.done(a.run(board, dt))
+}
+
+func (a *action) Reset() {
+}
+
+type wait struct {
+	behavior
+
+	duration float64
+	elapsed  float64
+}
+
+// Wait is a leaf that runs for a duration in milliseconds and then
+// succeeds.
+func Wait(duration float64) api.IBehavior {
+	o := new(wait)
+	o.name = "Wait"
+	o.duration = duration
+	return o
+}
+
+func (w *wait) Tick(board api.IBlackboard, dt float64) int {
+	w.elapsed += dt
+	if w.elapsed < w.duration {
+		return w.done(api.BehaviorRunning)
+	}
+
+	w.elapsed = 0.0
+	return w.done(api.BehaviorSuccess)
+}
+
+func (w *wait) Reset() {
+	w.elapsed = 0.0
+}
@@ -0,0 +1,91 @@
+package behavior
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/wdevore/RangerGo/api"
+)
+
+var statusNames = []string{"success", "failure", "running"}
+
+// Tree ticks a root behavior with a blackboard. Call Update from a
+// node's Update. Once the root finishes the next Update starts it over.
+type Tree struct {
+	root  api.IBehavior
+	board api.IBlackboard
+
+	status int
+}
+
+// NewTree constructs a tree with an empty blackboard
+func NewTree(root api.IBehavior) *Tree {
+	o := new(Tree)
+	o.root = root
+	o.board = NewBlackboard()
+	o.status = api.BehaviorRunning
+	return o
+}
+
+// Blackboard returns the tree's blackboard
+func (t *Tree) Blackboard() api.IBlackboard {
+	return t.board
+}
+
+// SetBlackboard shares a blackboard, for example, between trees.
+func (t *Tree) SetBlackboard(board api.IBlackboard) {
+	t.board = board
+}
+
+// Update ticks the root by dt milliseconds and returns its status
+func (t *Tree) Update(dt float64) int {
+	t.status = t.root.Tick(t.board, dt)
+	return t.status
+}
+
+// Status returns the root's status from the last Update
+func (t *Tree) Status() int {
+	return t.status
+}
+
+// Reset abandons any running behaviors
+func (t *Tree) Reset() {
+	t.root.Reset()
+}
+
+// Describe returns the path of running behaviors, one per line.
+func (t *Tree) Describe() string {
+	var b strings.Builder
+
+	indent := ""
+	for node := t.root; node != nil; {
+		if b.Len() > 0 {
+			b.WriteString("\n")
+		}
+		b.WriteString(indent + node.Name())
+
+		if tk, ok := node.(ticked); ok {
+			b.WriteString(" " + statusName(tk.last()))
+		}
+
+		p, ok := node.(parent)
+		if !ok {
+			break
+		}
+
+		node = p.active()
+		indent += "  "
+	}
+
+	return b.String()
+}
+
+// statusName names a status. Actions may return anything so unknown
+// statuses are shown as numbers.
+func statusName(status int) string {
+	if status < 0 || status >= len(statusNames) {
+		return strconv.Itoa(status)
+	}
+
+	return statusNames[status]
+}
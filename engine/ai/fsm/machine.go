@@ -0,0 +1,133 @@
+package fsm
+
+import (
+	"fmt"
+
+	"github.com/wdevore/RangerGo/api"
+)
+
+type transition struct {
+	from  string
+	to    string
+	guard func() bool
+}
+
+// Machine is a finite state machine driving a node. Call Update from
+// the node's Update, for example:
+//
+//	func (n *guardNode) Update(msPerUpdate, secPerUpdate float64) {
+//		n.brain.Update(msPerUpdate)
+//	}
+type Machine struct {
+	owner api.INode
+
+	states      map[string]api.IState
+	transitions []transition
+
+	current  api.IState
+	previous api.IState
+	elapsed  float64
+}
+
+// NewMachine constructs a machine whose states act on owner.
+func NewMachine(owner api.INode) *Machine {
+	o := new(Machine)
+	o.owner = owner
+	o.states = map[string]api.IState{}
+	return o
+}
+
+// AddState adds a state, replacing any of the same name
+func (m *Machine) AddState(state api.IState) {
+	m.states[state.Name()] = state
+}
+
+// AddTransition adds a guarded transition. Transitions are checked in
+// the order added and the first to pass wins. A nil guard always passes.
+func (m *Machine) AddTransition(from, to string, guard func() bool) {
+	m.transitions = append(m.transitions, transition{from: from, to: to, guard: guard})
+}
+
+// Start enters the named state
+func (m *Machine) Start(name string) {
+	state, ok := m.states[name]
+	if !ok {
+		fmt.Println("Machine: unknown state ", name)
+		return
+	}
+
+	m.current = state
+	m.previous = nil
+	m.elapsed = 0.0
+	state.Enter(m.owner)
+}
+
+// Change exits the current state and enters the named state. It
+// returns false if there is no such state.
+func (m *Machine) Change(name string) bool {
+	state, ok := m.states[name]
+	if !ok {
+		return false
+	}
+
+	if m.current != nil {
+		m.current.Exit(m.owner)
+	}
+
+	m.previous = m.current
+	m.current = state
+	m.elapsed = 0.0
+	state.Enter(m.owner)
+
+	return true
+}
+
+// Current returns the current state, nil before Start.
+func (m *Machine) Current() api.IState {
+	return m.current
+}
+
+// Previous returns the state before the current one
+func (m *Machine) Previous() api.IState {
+	return m.previous
+}
+
+// Elapsed returns the milliseconds spent in the current state
+func (m *Machine) Elapsed() float64 {
+	return m.elapsed
+}
+
+// Is returns true if the named state is current
+func (m *Machine) Is(name string) bool {
+	return m.current != nil && m.current.Name() == name
+}
+
+// Update takes at most one transition and then updates the current
+// state by dt milliseconds.
+func (m *Machine) Update(dt float64) {
+	if m.current == nil {
+		return
+	}
+
+	name := m.current.Name()
+	for _, t := range m.transitions {
+		if (t.from == name || t.from == "" && t.to != name) && (t.guard == nil || t.guard()) {
+			if !m.Change(t.to) {
+				fmt.Println("Machine: unknown state ", t.to, " from ", name)
+			}
+			break
+		}
+	}
+
+	m.elapsed += dt
+	m.current.Update(m.owner, dt)
+}
+
+// Describe returns the current state and how long it has been current.
+func (m *Machine) Describe() string {
+	if m.current == nil {
+		return "<stopped>"
+	}
+
+	return fmt.Sprintf("%s %0.1fs", m.current.Name(), m.elapsed/1000.0)
+}
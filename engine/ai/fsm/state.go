@@ -0,0 +1,63 @@
+package fsm
+
+import "github.com/wdevore/RangerGo/api"
+
+// State is an api.IState built from optional hook functions.
+type State struct {
+	name string
+
+	enter  func(owner api.INode)
+	update func(owner api.INode, dt float64)
+	exit   func(owner api.INode)
+}
+
+// NewState constructs a state that does nothing until hooks are set.
+func NewState(name string) *State {
+	o := new(State)
+	o.name = name
+	return o
+}
+
+// OnEnter sets the hook called when the state is entered
+func (s *State) OnEnter(enter func(owner api.INode)) *State {
+	s.enter = enter
+	return s
+}
+
+// OnUpdate sets the hook called each update while current
+func (s *State) OnUpdate(update func(owner api.INode, dt float64)) *State {
+	s.update = update
+	return s
+}
+
+// OnExit sets the hook called when the state is left
+func (s *State) OnExit(exit func(owner api.INode)) *State {
+	s.exit = exit
+	return s
+}
+
+// Name returns the state's name
+func (s *State) Name() string {
+	return s.name
+}
+
+// Enter calls the enter hook
+func (s *State) Enter(owner api.INode) {
+	if s.enter != nil {
+		s.enter(owner)
+	}
+}
+
+// Update calls the update hook
+func (s *State) Update(owner api.INode, dt float64) {
+	if s.update != nil {
+		s.update(owner, dt)
+	}
+}
+
+// Exit calls the exit hook
+func (s *State) Exit(owner api.INode) {
+	if s.exit != nil {
+		s.exit(owner)
+	}
+}
@@ -0,0 +1,74 @@
+package navigation
+
+import "container/heap"
+
+// graph is searched by astar. Nodes are ids from 0 to count-1.
+type graph interface {
+	count() int
+	neighbors(node int, visit func(next int, cost float64))
+	heuristic(node, goal int) float64
+}
+
+type openNode struct {
+	node int
+	f    float64
+}
+
+type openSet []openNode
+
+func (s openSet) Len() int            { return len(s) }
+func (s openSet) Less(i, j int) bool  { return s[i].f < s[j].f }
+func (s openSet) Swap(i, j int)       { s[i], s[j] = s[j], s[i] }
+func (s *openSet) Push(x interface{}) { *s = append(*s, x.(openNode)) }
+func (s *openSet) Pop() interface{} {
+	old := *s
+	n := old[len(old)-1]
+	*s = old[:len(old)-1]
+	return n
+}
+
+// astar returns the cheapest nodes from start to goal, inclusive.
+func astar(g graph, start, goal int) ([]int, bool) {
+	n := g.count()
+	cost := make([]float64, n)
+	from := make([]int, n)
+	closed := make([]bool, n)
+	for i := range from {
+		from[i] = -1
+		cost[i] = -1.0
+	}
+
+	open := &openSet{{node: start, f: g.heuristic(start, goal)}}
+	cost[start] = 0.0
+
+	for open.Len() > 0 {
+		current := heap.Pop(open).(openNode).node
+		if current == goal {
+			path := []int{}
+			for node := goal; node != -1; node = from[node] {
+				path = append([]int{node}, path...)
+			}
+			return path, true
+		}
+
+		if closed[current] {
+			// A stale entry for a node already reached more cheaply
+			continue
+		}
+		closed[current] = true
+
+		g.neighbors(current, func(next int, step float64) {
+			if closed[next] {
+				return
+			}
+			c := cost[current] + step
+			if cost[next] < 0.0 || c < cost[next] {
+				cost[next] = c
+				from[next] = current
+				heap.Push(open, openNode{node: next, f: c + g.heuristic(next, goal)})
+			}
+		})
+	}
+
+	return nil, false
+}
@@ -0,0 +1,226 @@
+package navigation
+
+import (
+	"math"
+
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine/geometry"
+)
+
+// Grid is an api.INavigator over square cells. Cells are open by
+// default and cost 1.0 to cross. Column 0, row 0 is the top-left cell.
+type Grid struct {
+	cols, rows int
+	size       float64
+
+	// Top-left corner of the grid
+	originX, originY float64
+
+	blocked  []bool
+	costs    []float64
+	diagonal int
+}
+
+// NewGrid constructs a grid whose top-left corner is at the origin
+func NewGrid(cols, rows int, cellSize float64) *Grid {
+	o := new(Grid)
+	o.cols = cols
+	o.rows = rows
+	o.size = cellSize
+	o.blocked = make([]bool, cols*rows)
+	o.costs = make([]float64, cols*rows)
+	for i := range o.costs {
+		o.costs[i] = 1.0
+	}
+	o.diagonal = api.DiagonalNoCorners
+	return o
+}
+
+// SetOrigin moves the grid's top-left corner
+func (g *Grid) SetOrigin(x, y float64) {
+	g.originX = x
+	g.originY = y
+}
+
+// SetDiagonal sets the diagonal rule, for example,
+// api.DiagonalNoCorners (default).
+func (g *Grid) SetDiagonal(rule int) {
+	g.diagonal = rule
+}
+
+// SetBlocked blocks or opens a cell
+func (g *Grid) SetBlocked(col, row int, blocked bool) {
+	if g.inside(col, row) {
+		g.blocked[row*g.cols+col] = blocked
+	}
+}
+
+// IsBlocked indicates if a cell is blocked. Cells outside the grid are
+// blocked.
+func (g *Grid) IsBlocked(col, row int) bool {
+	return !g.inside(col, row) || g.blocked[row*g.cols+col]
+}
+
+// SetCost sets how much a cell costs to cross relative to an open cell,
+// for example, 3.0 for mud. Costs below 1.0 are raised to 1.0.
+func (g *Grid) SetCost(col, row int, cost float64) {
+	if g.inside(col, row) {
+		g.costs[row*g.cols+col] = math.Max(cost, 1.0)
+	}
+}
+
+// BlockPolygon blocks the cells whose centers are inside a polygon.
+func (g *Grid) BlockPolygon(polygon api.IPolygon) {
+	p := geometry.NewPoint()
+	for row := 0; row < g.rows; row++ {
+		for col := 0; col < g.cols; col++ {
+			p.SetByComp(g.CellCenter(col, row))
+			if polygon.PointInside(p) {
+				g.SetBlocked(col, row, true)
+			}
+		}
+	}
+}
+
+// CellAt returns the cell containing a point
+func (g *Grid) CellAt(x, y float64) (col, row int, ok bool) {
+	col = int(math.Floor((x - g.originX) / g.size))
+	row = int(math.Floor((y - g.originY) / g.size))
+	return col, row, g.inside(col, row)
+}
+
+// CellCenter returns the center of a cell
+func (g *Grid) CellCenter(col, row int) (x, y float64) {
+	return g.originX + (float64(col)+0.5)*g.size, g.originY + (float64(row)+0.5)*g.size
+}
+
+// FindPath returns the points from one position to another through
+// open cells. Interior points are cell centers where the path turns.
+func (g *Grid) FindPath(fromX, fromY, toX, toY float64) ([]api.IPoint, bool) {
+	c0, r0, ok0 := g.CellAt(fromX, fromY)
+	c1, r1, ok1 := g.CellAt(toX, toY)
+	if !ok0 || !ok1 || g.IsBlocked(c0, r0) || g.IsBlocked(c1, r1) {
+		return nil, false
+	}
+
+	cells, found := astar(g, r0*g.cols+c0, r1*g.cols+c1)
+	if !found {
+		return nil, false
+	}
+
+	points := []api.IPoint{geometry.NewPointUsing(fromX, fromY)}
+
+	// Only keep cells where the direction changes.
+	for i := 1; i < len(cells)-1; i++ {
+		pc, pr := g.cellOf(cells[i-1])
+		c, r := g.cellOf(cells[i])
+		nc, nr := g.cellOf(cells[i+1])
+		if c-pc != nc-c || r-pr != nr-r {
+			points = append(points, geometry.NewPointUsing(g.CellCenter(c, r)))
+		}
+	}
+
+	return append(points, geometry.NewPointUsing(toX, toY)), true
+}
+
+// Edges returns lines between the centers of connected cells
+func (g *Grid) Edges() []api.IPoint {
+	edges := []api.IPoint{}
+
+	for row := 0; row < g.rows; row++ {
+		for col := 0; col < g.cols; col++ {
+			if g.IsBlocked(col, row) {
+				continue
+			}
+			x, y := g.CellCenter(col, row)
+
+			// Each link once: right, down and the downward diagonals
+			for _, d := range [][2]int{{1, 0}, {0, 1}, {1, 1}, {-1, 1}} {
+				if g.canMove(col, row, d[0], d[1]) {
+					edges = append(edges,
+						geometry.NewPointUsing(x, y),
+						geometry.NewPointUsing(g.CellCenter(col+d[0], row+d[1])))
+				}
+			}
+		}
+	}
+
+	return edges
+}
+
+func (g *Grid) inside(col, row int) bool {
+	return col >= 0 && col < g.cols && row >= 0 && row < g.rows
+}
+
+func (g *Grid) cellOf(node int) (col, row int) {
+	return node % g.cols, node / g.cols
+}
+
+// canMove indicates if a move from a cell by a step is allowed
+func (g *Grid) canMove(col, row, dc, dr int) bool {
+	if g.IsBlocked(col+dc, row+dr) {
+		return false
+	}
+
+	if dc == 0 || dr == 0 {
+		return true
+	}
+
+	open := 0
+	if !g.IsBlocked(col+dc, row) {
+		open++
+	}
+	if !g.IsBlocked(col, row+dr) {
+		open++
+	}
+
+	switch g.diagonal {
+	case api.DiagonalNoCorners:
+		return open == 2
+	case api.DiagonalOneOpen:
+		return open > 0
+	case api.DiagonalAlways:
+		return true
+	}
+	return false
+}
+
+// --------------------------------------------------------
+// graph
+// --------------------------------------------------------
+
+func (g *Grid) count() int {
+	return g.cols * g.rows
+}
+
+func (g *Grid) neighbors(node int, visit func(next int, cost float64)) {
+	col, row := g.cellOf(node)
+
+	for dr := -1; dr <= 1; dr++ {
+		for dc := -1; dc <= 1; dc++ {
+			if (dc == 0 && dr == 0) || !g.canMove(col, row, dc, dr) {
+				continue
+			}
+			next := (row+dr)*g.cols + col + dc
+			step := 1.0
+			if dc != 0 && dr != 0 {
+				step = math.Sqrt2
+			}
+			visit(next, step*g.size*g.costs[next])
+		}
+	}
+}
+
+// heuristic is the octile distance
+func (g *Grid) heuristic(node, goal int) float64 {
+	c0, r0 := g.cellOf(node)
+	c1, r1 := g.cellOf(goal)
+	dc := math.Abs(float64(c1 - c0))
+	dr := math.Abs(float64(r1 - r0))
+
+	if g.diagonal == api.DiagonalNever {
+		return (dc + dr) * g.size
+	}
+
+	return (math.Max(dc, dr) + (math.Sqrt2-1.0)*math.Min(dc, dr)) * g.size
+}
@@ -0,0 +1,246 @@
+package navigation
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine/geometry"
+)
+
+type triangle struct {
+	// Vertex indices with positive winding
+	v [3]int
+	// Neighbor across the edge v[i] -> v[i+1], or -1
+	neighbors [3]int
+	center    vec
+}
+
+// NavMesh is an api.INavigator over the triangles of the open space
+// between obstacles. Paths are smoothed with the funnel algorithm.
+type NavMesh struct {
+	vertices  []vec
+	triangles []triangle
+}
+
+// NewNavMesh triangulates the space inside a boundary around obstacle
+// polygons. Obstacles must be inside the boundary and not overlap each
+// other. Enlarge the obstacles by the agents' radius to keep agents
+// from brushing them.
+func NewNavMesh(boundary api.IPolygon, obstacles ...api.IPolygon) (*NavMesh, error) {
+	holes := [][]vec{}
+	for _, o := range obstacles {
+		holes = append(holes, ringOf(o))
+	}
+
+	triangles, err := triangulate(ringOf(boundary), holes)
+	if err != nil {
+		return nil, err
+	}
+
+	o := new(NavMesh)
+	o.build(triangles)
+	return o, nil
+}
+
+// ringOf returns a polygon's vertices without a repeated closing vertex
+func ringOf(polygon api.IPolygon) []vec {
+	ring := []vec{}
+	for _, p := range polygon.Mesh().Vertices() {
+		v := vec{p.X(), p.Y()}
+		if len(ring) == 0 || !ring[len(ring)-1].equals(v) {
+			ring = append(ring, v)
+		}
+	}
+	if len(ring) > 1 && ring[0].equals(ring[len(ring)-1]) {
+		ring = ring[:len(ring)-1]
+	}
+	return ring
+}
+
+// build shares vertices between triangles and links neighbors
+func (m *NavMesh) build(triangles [][3]vec) {
+	index := map[[2]int64]int{}
+	key := func(v vec) [2]int64 {
+		return [2]int64{int64(math.Round(v.x * 1e6)), int64(math.Round(v.y * 1e6))}
+	}
+
+	edges := map[[2]int]int{}
+
+	for _, t := range triangles {
+		tri := triangle{neighbors: [3]int{-1, -1, -1}}
+		for i, v := range t {
+			k := key(v)
+			id, ok := index[k]
+			if !ok {
+				id = len(m.vertices)
+				index[k] = id
+				m.vertices = append(m.vertices, v)
+			}
+			tri.v[i] = id
+		}
+		tri.center = vec{(t[0].x + t[1].x + t[2].x) / 3.0, (t[0].y + t[1].y + t[2].y) / 3.0}
+
+		ti := len(m.triangles)
+		for i := 0; i < 3; i++ {
+			a, b := tri.v[i], tri.v[(i+1)%3]
+			// The neighbor has the edge in the opposite direction.
+			if other, ok := edges[[2]int{b, a}]; ok {
+				tri.neighbors[i] = other / 3
+				m.triangles[other/3].neighbors[other%3] = ti
+			}
+			edges[[2]int{a, b}] = ti*3 + i
+		}
+
+		m.triangles = append(m.triangles, tri)
+	}
+}
+
+// Triangles returns the number of triangles
+func (m *NavMesh) Triangles() int {
+	return len(m.triangles)
+}
+
+// Edges returns the triangles' edges
+func (m *NavMesh) Edges() []api.IPoint {
+	edges := []api.IPoint{}
+	for ti, t := range m.triangles {
+		for i := 0; i < 3; i++ {
+			// Shared edges once
+			if n := t.neighbors[i]; n >= 0 && n < ti {
+				continue
+			}
+			a, b := m.vertices[t.v[i]], m.vertices[t.v[(i+1)%3]]
+			edges = append(edges, geometry.NewPointUsing(a.x, a.y), geometry.NewPointUsing(b.x, b.y))
+		}
+	}
+	return edges
+}
+
+// FindPath returns the shortest path, within the mesh, from one point
+// to another.
+func (m *NavMesh) FindPath(fromX, fromY, toX, toY float64) ([]api.IPoint, bool) {
+	from, to := vec{fromX, fromY}, vec{toX, toY}
+
+	start, goal := m.locate(from), m.locate(to)
+	if start < 0 || goal < 0 {
+		return nil, false
+	}
+
+	corridor, found := astar(&meshGraph{m}, start, goal)
+	if !found {
+		return nil, false
+	}
+
+	points := []api.IPoint{}
+	for _, v := range m.funnel(from, to, corridor) {
+		points = append(points, geometry.NewPointUsing(v.x, v.y))
+	}
+	return points, true
+}
+
+// locate returns the triangle containing a point, or -1
+func (m *NavMesh) locate(p vec) int {
+	for i, t := range m.triangles {
+		if insideTriangle(p, m.vertices[t.v[0]], m.vertices[t.v[1]], m.vertices[t.v[2]]) {
+			return i
+		}
+	}
+	return -1
+}
+
+// funnel pulls a string through the corridor's portals.
+func (m *NavMesh) funnel(from, to vec, corridor []int) []vec {
+	// Portals as seen walking through them. The first and last are the
+	// end points.
+	lefts := []vec{from}
+	rights := []vec{from}
+	for i := 0; i < len(corridor)-1; i++ {
+		t := m.triangles[corridor[i]]
+		for e := 0; e < 3; e++ {
+			if t.neighbors[e] == corridor[i+1] {
+				// Leaving a triangle with positive winding, the edge's
+				// end is on the left.
+				rights = append(rights, m.vertices[t.v[e]])
+				lefts = append(lefts, m.vertices[t.v[(e+1)%3]])
+				break
+			}
+		}
+	}
+	lefts = append(lefts, to)
+	rights = append(rights, to)
+
+	path := []vec{from}
+	apex, left, right := from, from, from
+	apexIndex, leftIndex, rightIndex := 0, 0, 0
+
+	for i := 1; i < len(lefts); i++ {
+		l, r := lefts[i], rights[i]
+
+		// Tighten the right side
+		if turn(apex, right, r) >= 0.0 {
+			if apex.equals(right) || turn(apex, left, r) < 0.0 {
+				right = r
+				rightIndex = i
+			} else {
+				// The right crossed the left, so the left is a corner.
+				path = append(path, left)
+				apex, apexIndex = left, leftIndex
+				left, right = apex, apex
+				leftIndex, rightIndex = apexIndex, apexIndex
+				i = apexIndex
+				continue
+			}
+		}
+
+		// Tighten the left side
+		if turn(apex, left, l) <= 0.0 {
+			if apex.equals(left) || turn(apex, right, l) > 0.0 {
+				left = l
+				leftIndex = i
+			} else {
+				path = append(path, right)
+				apex, apexIndex = right, rightIndex
+				left, right = apex, apex
+				leftIndex, rightIndex = apexIndex, apexIndex
+				i = apexIndex
+				continue
+			}
+		}
+	}
+
+	if !path[len(path)-1].equals(to) {
+		path = append(path, to)
+	}
+	return path
+}
+
+func (m NavMesh) String() string {
+	return fmt.Sprintf("NavMesh: %d vertices, %d triangles", len(m.vertices), len(m.triangles))
+}
+
+// meshGraph searches the triangles, stepping between their centers
+type meshGraph struct {
+	mesh *NavMesh
+}
+
+func (g *meshGraph) count() int {
+	return len(g.mesh.triangles)
+}
+
+func (g *meshGraph) neighbors(node int, visit func(next int, cost float64)) {
+	t := g.mesh.triangles[node]
+	for _, n := range t.neighbors {
+		if n >= 0 {
+			c := g.mesh.triangles[n].center
+			visit(n, math.Hypot(c.x-t.center.x, c.y-t.center.y))
+		}
+	}
+}
+
+// heuristic measures to the goal triangle's center, like the steps, so
+// it never overestimates the remaining cost.
+func (g *meshGraph) heuristic(node, goal int) float64 {
+	a, b := g.mesh.triangles[node].center, g.mesh.triangles[goal].center
+	return math.Hypot(b.x-a.x, b.y-a.y)
+}
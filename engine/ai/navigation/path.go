@@ -0,0 +1,17 @@
+package navigation
+
+import (
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine/geometry"
+)
+
+// ToPath builds a path of straight lines through the points, for
+// example, for actions.FollowPath or steering.FollowPath.
+func ToPath(points []api.IPoint) api.IPath {
+	path := geometry.NewLinearPath()
+	for _, p := range points {
+		path.AddPoint(p.X(), p.Y())
+	}
+	path.Build()
+	return path
+}
@@ -0,0 +1,283 @@
+package navigation
+
+import (
+	"errors"
+	"math"
+	"sort"
+)
+
+const epsilon = 1e-9
+
+type vec struct {
+	x, y float64
+}
+
+func (a vec) sub(b vec) vec {
+	return vec{a.x - b.x, a.y - b.y}
+}
+
+func (a vec) equals(b vec) bool {
+	return math.Abs(a.x-b.x) < 1e-6 && math.Abs(a.y-b.y) < 1e-6
+}
+
+func cross(a, b vec) float64 {
+	return a.x*b.y - a.y*b.x
+}
+
+// turn is positive when a -> b -> c turns toward positive rotations.
+func turn(a, b, c vec) float64 {
+	return cross(b.sub(a), c.sub(b))
+}
+
+func area(ring []vec) float64 {
+	a := 0.0
+	for i := range ring {
+		j := (i + 1) % len(ring)
+		a += ring[i].x*ring[j].y - ring[j].x*ring[i].y
+	}
+	return a / 2.0
+}
+
+func reversed(ring []vec) []vec {
+	r := make([]vec, len(ring))
+	for i, v := range ring {
+		r[len(ring)-1-i] = v
+	}
+	return r
+}
+
+// insideTriangle includes the edges. The triangle has positive
+// winding.
+func insideTriangle(p, a, b, c vec) bool {
+	return turn(a, b, p) >= -epsilon && turn(b, c, p) >= -epsilon && turn(c, a, p) >= -epsilon
+}
+
+// triangulate splits a polygon with holes into triangles with positive
+// winding. The holes must be inside the outer ring and not overlap.
+func triangulate(outer []vec, holes [][]vec) ([][3]vec, error) {
+	if area(outer) < 0.0 {
+		outer = reversed(outer)
+	}
+
+	for i, h := range holes {
+		for _, v := range h {
+			if !strictlyInside(v, outer) {
+				return nil, errors.New("navmesh: obstacles must be inside the boundary")
+			}
+		}
+		if area(h) > 0.0 {
+			holes[i] = reversed(h)
+		}
+	}
+
+	// Bridge holes in from the rightmost so that bridges don't cross
+	// holes yet to be bridged.
+	sort.SliceStable(holes, func(i, j int) bool {
+		return maxX(holes[i]) > maxX(holes[j])
+	})
+
+	ring := append([]vec{}, outer...)
+	for _, h := range holes {
+		var err error
+		ring, err = bridge(ring, h)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return clipEars(ring)
+}
+
+// strictlyInside excludes points on the ring's edges
+func strictlyInside(p vec, ring []vec) bool {
+	inside := false
+	for i := range ring {
+		a, b := ring[i], ring[(i+1)%len(ring)]
+
+		// On the edge?
+		ab, ap := b.sub(a), p.sub(a)
+		if math.Abs(cross(ab, ap)) < epsilon {
+			t := (ap.x*ab.x + ap.y*ab.y) / (ab.x*ab.x + ab.y*ab.y)
+			if t >= 0.0 && t <= 1.0 {
+				return false
+			}
+		}
+
+		if (a.y > p.y) != (b.y > p.y) && p.x < a.x+(p.y-a.y)*(b.x-a.x)/(b.y-a.y) {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+func maxX(ring []vec) float64 {
+	m := -math.MaxFloat64
+	for _, v := range ring {
+		m = math.Max(m, v.x)
+	}
+	return m
+}
+
+// bridge joins a hole to the ring with a pair of coincident edges from
+// the hole's rightmost vertex to a ring vertex visible from it.
+func bridge(ring, hole []vec) ([]vec, error) {
+	m := 0
+	for i, v := range hole {
+		if v.x > hole[m].x {
+			m = i
+		}
+	}
+	mv := hole[m]
+
+	// Cast a ray to the right for the nearest ring edge. With positive
+	// winding only edges heading toward +y face the ray.
+	hit := math.MaxFloat64
+	edge := -1
+	for i := range ring {
+		a, b := ring[i], ring[(i+1)%len(ring)]
+		if a.y >= b.y || mv.y < a.y || mv.y > b.y {
+			continue
+		}
+		x := a.x + (mv.y-a.y)*(b.x-a.x)/(b.y-a.y)
+		if x >= mv.x && x < hit {
+			hit = x
+			edge = i
+		}
+	}
+
+	if edge < 0 {
+		return nil, errors.New("navmesh: obstacle outside the boundary")
+	}
+
+	i := vec{hit, mv.y}
+	a, b := ring[edge], ring[(edge+1)%len(ring)]
+
+	// The candidate is the hit vertex, otherwise the edge's end with
+	// the larger x.
+	p := edge
+	if b.x > a.x {
+		p = (edge + 1) % len(ring)
+	}
+	if i.equals(a) {
+		p = edge
+	} else if i.equals(b) {
+		p = (edge + 1) % len(ring)
+	} else {
+		// Reflex vertices inside the triangle M, I, P may hide P. Pick
+		// the one closest in angle to the ray.
+		best := math.MaxFloat64
+		candidate := p
+		for j, r := range ring {
+			if j == p || !isReflex(ring, j) {
+				continue
+			}
+			if !insideTriangle(r, mv, i, ring[p]) && !insideTriangle(r, mv, ring[p], i) {
+				continue
+			}
+			d := r.sub(mv)
+			angle := math.Atan2(math.Abs(d.y), d.x)
+			if angle < best {
+				best = angle
+				candidate = j
+			}
+		}
+		p = candidate
+	}
+
+	// Bridged vertices appear twice. Use the copy facing the hole.
+	p = facing(ring, p, mv)
+
+	merged := append([]vec{}, ring[:p+1]...)
+	for k := 0; k <= len(hole); k++ {
+		merged = append(merged, hole[(m+k)%len(hole)])
+	}
+	merged = append(merged, ring[p])
+	return append(merged, ring[p+1:]...), nil
+}
+
+// facing returns the copy of vertex i whose interior angle contains
+// the direction to a point.
+func facing(ring []vec, i int, to vec) int {
+	n := len(ring)
+	for j, v := range ring {
+		if !v.equals(ring[i]) {
+			continue
+		}
+
+		a := ring[(j+n-1)%n].sub(v)
+		b := ring[(j+1)%n].sub(v)
+		d := to.sub(v)
+
+		// The interior is swept from b to a with positive rotation.
+		if cross(b, a) >= 0.0 {
+			if cross(b, d) > 0.0 && cross(d, a) > 0.0 {
+				return j
+			}
+		} else if !(cross(a, d) >= 0.0 && cross(d, b) >= 0.0) {
+			return j
+		}
+	}
+	return i
+}
+
+func isReflex(ring []vec, i int) bool {
+	n := len(ring)
+	return turn(ring[(i+n-1)%n], ring[i], ring[(i+1)%n]) <= 0.0
+}
+
+// clipEars triangulates a ring with positive winding.
+func clipEars(ring []vec) ([][3]vec, error) {
+	triangles := [][3]vec{}
+	ring = append([]vec{}, ring...)
+
+	for len(ring) > 3 {
+		n := len(ring)
+		clipped := false
+
+		for i := 0; i < n; i++ {
+			a, b, c := ring[(i+n-1)%n], ring[i], ring[(i+1)%n]
+
+			t := turn(a, b, c)
+			if math.Abs(t) < epsilon {
+				// Collinear points and the spikes left by bridges have
+				// no area.
+				if a.equals(c) || math.Abs(cross(b.sub(a), c.sub(a))) < epsilon {
+					ring = append(ring[:i], ring[i+1:]...)
+					clipped = true
+					break
+				}
+			}
+			if t <= 0.0 || !isEar(ring, a, b, c) {
+				continue
+			}
+
+			triangles = append(triangles, [3]vec{a, b, c})
+			ring = append(ring[:i], ring[i+1:]...)
+			clipped = true
+			break
+		}
+
+		if !clipped {
+			return nil, errors.New("navmesh: unable to triangulate, are obstacles overlapping?")
+		}
+	}
+
+	if len(ring) == 3 && turn(ring[0], ring[1], ring[2]) > epsilon {
+		triangles = append(triangles, [3]vec{ring[0], ring[1], ring[2]})
+	}
+
+	return triangles, nil
+}
+
+// isEar checks no other vertex is inside the triangle
+func isEar(ring []vec, a, b, c vec) bool {
+	for _, v := range ring {
+		if v.equals(a) || v.equals(b) || v.equals(c) {
+			continue
+		}
+		if insideTriangle(v, a, b, c) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,178 @@
+package steering
+
+import (
+	"math"
+
+	"github.com/ByteArena/box2d"
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine/geometry"
+	"github.com/wdevore/RangerGo/engine/maths"
+)
+
+// NodeAgent steers a node by integrating its velocity
+type NodeAgent struct {
+	node     api.INode
+	velocity api.IVelocity
+	maxForce float64
+	radius   float64
+	orient   bool
+
+	v api.IVector
+}
+
+// NewNodeAgent constructs an agent for a node with a max speed, in
+// units per second, and max force.
+func NewNodeAgent(node api.INode, maxSpeed, maxForce float64) *NodeAgent {
+	o := new(NodeAgent)
+	o.node = node
+	o.velocity = maths.NewVelocity()
+	o.velocity.SetMinMax(0.0, maxSpeed)
+	o.maxForce = maxForce
+	o.radius = node.Scale() / 2.0
+	o.v = maths.NewVector()
+	return o
+}
+
+// SetRadius sets the agent's size, default is half the node's scale
+func (a *NodeAgent) SetRadius(radius float64) {
+	a.radius = radius
+}
+
+// SetOrient enables rotating the node to face its velocity
+func (a *NodeAgent) SetOrient(orient bool) {
+	a.orient = orient
+}
+
+// Position returns the node's position
+func (a *NodeAgent) Position() api.IPoint {
+	return a.node.Position()
+}
+
+// Velocity returns the agent's velocity
+func (a *NodeAgent) Velocity() api.IVelocity {
+	return a.velocity
+}
+
+// MaxForce returns the steering limit
+func (a *NodeAgent) MaxForce() float64 {
+	return a.maxForce
+}
+
+// Radius returns the agent's size
+func (a *NodeAgent) Radius() float64 {
+	return a.radius
+}
+
+// ApplyForce accelerates the agent and moves the node
+func (a *NodeAgent) ApplyForce(force api.IVector, dt float64) {
+	velocityOf(a.velocity, a.v)
+	a.v.Add(force.X()*dt, force.Y()*dt)
+	setVelocity(a.velocity, a.v)
+
+	pos := a.node.Position()
+	velocityOf(a.velocity, a.v)
+	a.node.SetPosition(pos.X()+a.v.X()*dt, pos.Y()+a.v.Y()*dt)
+
+	if a.orient && a.velocity.Magnitude() > maths.Epsilon {
+		a.node.SetRotation(math.Atan2(a.v.Y(), a.v.X()))
+	}
+}
+
+// BodyAgent steers a Box2D body by applying forces to it. Its steering
+// never takes the body's linear velocity past the max speed; call
+// LimitSpeed after the world steps to also cap other forces, for
+// example, gravity or collisions.
+type BodyAgent struct {
+	body     *box2d.B2Body
+	velocity api.IVelocity
+	maxForce float64
+	radius   float64
+
+	position api.IPoint
+	v        api.IVector
+}
+
+// NewBodyAgent constructs an agent for a body
+func NewBodyAgent(body *box2d.B2Body, maxSpeed, maxForce, radius float64) *BodyAgent {
+	o := new(BodyAgent)
+	o.body = body
+	o.velocity = maths.NewVelocity()
+	o.velocity.SetMinMax(0.0, maxSpeed)
+	o.maxForce = maxForce
+	o.radius = radius
+	o.position = geometry.NewPoint()
+	o.v = maths.NewVector()
+	return o
+}
+
+// Position returns the body's position
+func (a *BodyAgent) Position() api.IPoint {
+	pos := a.body.GetPosition()
+	a.position.SetByComp(pos.X, pos.Y)
+	return a.position
+}
+
+// Velocity returns the body's linear velocity
+func (a *BodyAgent) Velocity() api.IVelocity {
+	lv := a.body.GetLinearVelocity()
+	a.v.SetByComp(lv.X, lv.Y)
+	setVelocity(a.velocity, a.v)
+	return a.velocity
+}
+
+// MaxForce returns the steering limit
+func (a *BodyAgent) MaxForce() float64 {
+	return a.maxForce
+}
+
+// Radius returns the agent's size
+func (a *BodyAgent) Radius() float64 {
+	return a.radius
+}
+
+// ApplyForce applies the force, scaled by the body's mass, to the
+// body's center. Box2D moves the body when it steps, by dt, so the
+// force is reduced to keep the velocity after the step within the max
+// speed.
+func (a *BodyAgent) ApplyForce(force api.IVector, dt float64) {
+	if dt <= 0.0 {
+		return
+	}
+
+	// The velocity Box2D integrates to, limited to the max speed.
+	lv := a.body.GetLinearVelocity()
+	a.v.SetByComp(lv.X+force.X()*dt, lv.Y+force.Y()*dt)
+	setVelocity(a.velocity, a.v)
+	velocityOf(a.velocity, a.v)
+
+	mass := a.body.GetMass()
+	ax := (a.v.X() - lv.X) / dt
+	ay := (a.v.Y() - lv.Y) / dt
+	a.body.ApplyForce(box2d.MakeB2Vec2(ax*mass, ay*mass), a.body.GetWorldCenter(), true)
+}
+
+// LimitSpeed clamps the body's linear velocity to the max speed. Call
+// it after the world steps.
+func (a *BodyAgent) LimitSpeed() {
+	velocityOf(a.Velocity(), a.v)
+	a.body.SetLinearVelocity(box2d.MakeB2Vec2(a.v.X(), a.v.Y()))
+}
+
+// velocityOf sets out to the velocity as a vector
+func velocityOf(velocity api.IVelocity, out api.IVector) {
+	dir := velocity.Direction()
+	out.SetByComp(dir.X()*velocity.Magnitude(), dir.Y()*velocity.Magnitude())
+}
+
+// setVelocity sets the velocity from a vector, limited to its max
+// magnitude.
+func setVelocity(velocity api.IVelocity, v api.IVector) {
+	speed := v.Length()
+	if speed > maths.Epsilon {
+		velocity.SetDirectionByVector(v)
+		velocity.Direction().Normalize()
+	}
+
+	_, max := velocity.Range()
+	velocity.SetMagnitude(math.Min(speed, max))
+}
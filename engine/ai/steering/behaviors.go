@@ -0,0 +1,311 @@
+package steering
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine/geometry"
+	"github.com/wdevore/RangerGo/engine/maths"
+)
+
+// responseTime is how quickly, in seconds, behaviours ask to reach
+// their desired velocity. Agents' max force limits how quickly they do.
+const responseTime = 0.1
+
+// seek sets force toward a point at full speed
+func seek(agent api.ISteeringAgent, x, y float64, force api.IVector) {
+	pos := agent.Position()
+	desired(agent, x-pos.X(), y-pos.Y(), maxSpeed(agent), force)
+}
+
+// arrive sets force toward a point, slowing within a radius
+func arrive(agent api.ISteeringAgent, x, y, slowing float64, force api.IVector) {
+	pos := agent.Position()
+	dx, dy := x-pos.X(), y-pos.Y()
+
+	speed := maxSpeed(agent)
+	if d := math.Hypot(dx, dy); d < slowing {
+		speed *= d / slowing
+	}
+
+	desired(agent, dx, dy, speed, force)
+}
+
+// desired sets force to steer the agent's velocity toward a direction
+// at a speed.
+func desired(agent api.ISteeringAgent, dx, dy, speed float64, force api.IVector) {
+	force.SetByComp(dx, dy)
+	if force.LengthSqr() > maths.Epsilon {
+		force.Normalize()
+	}
+	force.Scale(speed)
+
+	velocity := agent.Velocity()
+	dir := velocity.Direction()
+	force.Sub(dir.X()*velocity.Magnitude(), dir.Y()*velocity.Magnitude())
+	force.Scale(1.0 / responseTime)
+}
+
+func maxSpeed(agent api.ISteeringAgent) float64 {
+	_, max := agent.Velocity().Range()
+	return max
+}
+
+// predict returns where an agent will be in t seconds
+func predict(agent api.ISteeringAgent, t float64) (x, y float64) {
+	pos := agent.Position()
+	velocity := agent.Velocity()
+	dir := velocity.Direction()
+	return pos.X() + dir.X()*velocity.Magnitude()*t, pos.Y() + dir.Y()*velocity.Magnitude()*t
+}
+
+// --------------------------------------------------------
+// Seek, flee and arrive
+// --------------------------------------------------------
+
+type seekBehavior struct {
+	target api.IPoint
+}
+
+// Seek steers toward a point at full speed. The point can be moved.
+func Seek(target api.IPoint) api.ISteeringBehavior {
+	return &seekBehavior{target: target}
+}
+
+func (b *seekBehavior) Steer(agent api.ISteeringAgent, force api.IVector) {
+	seek(agent, b.target.X(), b.target.Y(), force)
+}
+
+type fleeBehavior struct {
+	target api.IPoint
+	panic  float64
+}
+
+// Flee steers away from a point while within a panic distance. A
+// distance of 0.0 always flees.
+func Flee(target api.IPoint, panicDistance float64) api.ISteeringBehavior {
+	return &fleeBehavior{target: target, panic: panicDistance}
+}
+
+func (b *fleeBehavior) Steer(agent api.ISteeringAgent, force api.IVector) {
+	flee(agent, b.target.X(), b.target.Y(), b.panic, force)
+}
+
+func flee(agent api.ISteeringAgent, x, y, panic float64, force api.IVector) {
+	pos := agent.Position()
+	dx, dy := pos.X()-x, pos.Y()-y
+
+	if panic > 0.0 && math.Hypot(dx, dy) > panic {
+		force.SetByComp(0.0, 0.0)
+		return
+	}
+
+	desired(agent, dx, dy, maxSpeed(agent), force)
+}
+
+type arriveBehavior struct {
+	target  api.IPoint
+	slowing float64
+}
+
+// Arrive steers toward a point, slowing to a stop within the slowing
+// radius.
+func Arrive(target api.IPoint, slowingRadius float64) api.ISteeringBehavior {
+	return &arriveBehavior{target: target, slowing: slowingRadius}
+}
+
+func (b *arriveBehavior) Steer(agent api.ISteeringAgent, force api.IVector) {
+	arrive(agent, b.target.X(), b.target.Y(), b.slowing, force)
+}
+
+// --------------------------------------------------------
+// Pursue and evade
+// --------------------------------------------------------
+
+type pursueBehavior struct {
+	quarry api.ISteeringAgent
+	evade  bool
+	panic  float64
+}
+
+// Pursue seeks where another agent is heading.
+func Pursue(quarry api.ISteeringAgent) api.ISteeringBehavior {
+	return &pursueBehavior{quarry: quarry}
+}
+
+// Evade flees from where another agent is heading while it is within
+// a panic distance. A distance of 0.0 always evades.
+func Evade(pursuer api.ISteeringAgent, panicDistance float64) api.ISteeringBehavior {
+	return &pursueBehavior{quarry: pursuer, evade: true, panic: panicDistance}
+}
+
+func (b *pursueBehavior) Steer(agent api.ISteeringAgent, force api.IVector) {
+	// Look ahead by the time it takes to close the distance.
+	pos := agent.Position()
+	other := b.quarry.Position()
+	d := math.Hypot(other.X()-pos.X(), other.Y()-pos.Y())
+
+	t := 0.0
+	if speed := maxSpeed(agent) + b.quarry.Velocity().Magnitude(); speed > 0.0 {
+		t = d / speed
+	}
+
+	x, y := predict(b.quarry, t)
+
+	if b.evade {
+		flee(agent, x, y, b.panic, force)
+	} else {
+		seek(agent, x, y, force)
+	}
+}
+
+// --------------------------------------------------------
+// Wander
+// --------------------------------------------------------
+
+type wanderBehavior struct {
+	radius, distance, jitter float64
+	angle                    float64
+}
+
+// Wander steers toward a point that drifts around a circle ahead of
+// the agent. Jitter is the most the point moves, in radians, per
+// steer.
+func Wander(radius, distance, jitter float64) api.ISteeringBehavior {
+	return &wanderBehavior{radius: radius, distance: distance, jitter: jitter}
+}
+
+func (b *wanderBehavior) Steer(agent api.ISteeringAgent, force api.IVector) {
+	b.angle += (rand.Float64()*2.0 - 1.0) * b.jitter
+
+	pos := agent.Position()
+	dir := agent.Velocity().Direction()
+	heading := math.Atan2(dir.Y(), dir.X())
+
+	x := pos.X() + dir.X()*b.distance + math.Cos(heading+b.angle)*b.radius
+	y := pos.Y() + dir.Y()*b.distance + math.Sin(heading+b.angle)*b.radius
+
+	seek(agent, x, y, force)
+}
+
+// --------------------------------------------------------
+// Obstacle avoidance
+// --------------------------------------------------------
+
+type avoidBehavior struct {
+	obstacles []*geometry.Circle
+	lookAhead float64
+}
+
+// AvoidObstacles steers sideways away from the nearest circle in front
+// of the agent. The agent looks further ahead the faster it goes, up
+// to lookAhead seconds at full speed.
+func AvoidObstacles(obstacles []*geometry.Circle, lookAhead float64) api.ISteeringBehavior {
+	return &avoidBehavior{obstacles: obstacles, lookAhead: lookAhead}
+}
+
+func (b *avoidBehavior) Steer(agent api.ISteeringAgent, force api.IVector) {
+	force.SetByComp(0.0, 0.0)
+
+	pos := agent.Position()
+	velocity := agent.Velocity()
+	dir := velocity.Direction()
+
+	// The detection box's length. It is never shorter than the agent.
+	length := agent.Radius() + velocity.Magnitude()*b.lookAhead
+
+	nearest := math.MaxFloat64
+	side := 0.0
+
+	for _, o := range b.obstacles {
+		// The obstacle in the agent's heading-space
+		cx := o.Center().X() - pos.X()
+		cy := o.Center().Y() - pos.Y()
+		ahead := cx*dir.X() + cy*dir.Y()
+		lateral := cx*-dir.Y() + cy*dir.X()
+
+		reach := o.Radius() + agent.Radius()
+		if ahead < -reach || ahead > length+reach || math.Abs(lateral) >= reach {
+			continue
+		}
+
+		if ahead < nearest {
+			nearest = ahead
+			// Steer to the side away from the obstacle. Closer
+			// obstacles steer harder.
+			strength := 1.0 + (length-math.Max(ahead, 0.0))/length
+			side = (reach - math.Abs(lateral)) / reach * strength
+			if lateral > 0.0 {
+				side = -side
+			}
+		}
+	}
+
+	if side != 0.0 {
+		f := side * agent.MaxForce()
+		force.SetByComp(-dir.Y()*f, dir.X()*f)
+	}
+}
+
+// --------------------------------------------------------
+// Path following
+// --------------------------------------------------------
+
+type pathBehavior struct {
+	path     api.IPath
+	ahead    float64
+	distance float64
+	p        api.IPoint
+}
+
+// FollowPath steers along a path toward a point a distance ahead of
+// the agent's progress. Open paths arrive at their end. Progress is
+// searched in steps of a 500th of the path, or one unit, and the agent
+// looks at least a step ahead.
+func FollowPath(path api.IPath, ahead float64) api.ISteeringBehavior {
+	return &pathBehavior{path: path, ahead: ahead, p: geometry.NewPoint()}
+}
+
+func (b *pathBehavior) Steer(agent api.ISteeringAgent, force api.IVector) {
+	length := b.path.Length()
+	if length == 0.0 {
+		force.SetByComp(0.0, 0.0)
+		return
+	}
+
+	// Search near the current progress for the closest point to
+	// where the agent will be shortly.
+	x, y := predict(agent, 0.1)
+	step := math.Max(length/500.0, 1.0)
+	best := math.MaxFloat64
+	from := b.distance
+	// Looking less than a step ahead the agent would seek its own
+	// progress and never advance.
+	ahead := math.Max(b.ahead, step)
+
+	for d := from - ahead; d <= from+ahead*2.0; d += step {
+		if !b.path.IsClosed() && (d < 0.0 || d > length) {
+			continue
+		}
+		b.path.PointAt(d, b.p)
+		if dd := math.Hypot(b.p.X()-x, b.p.Y()-y); dd < best {
+			best = dd
+			b.distance = d
+		}
+	}
+
+	if b.path.IsClosed() {
+		b.distance = math.Mod(b.distance+length, length)
+	}
+
+	target := b.distance + ahead
+	if !b.path.IsClosed() && target >= length {
+		b.path.PointAt(length, b.p)
+		arrive(agent, b.p.X(), b.p.Y(), ahead, force)
+		return
+	}
+
+	b.path.PointAt(target, b.p)
+	seek(agent, b.p.X(), b.p.Y(), force)
+}
@@ -0,0 +1,82 @@
+package steering
+
+import (
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine/maths"
+)
+
+type weightedBehavior struct {
+	behavior api.ISteeringBehavior
+	weight   float64
+}
+
+// Combination combines behaviours by weight or by priority. It is
+// itself a behaviour so combinations can be nested.
+type Combination struct {
+	behaviors   []weightedBehavior
+	prioritized bool
+
+	f api.IVector
+}
+
+// NewWeighted constructs a combination that sums the weighted forces of
+// all its behaviours.
+func NewWeighted() *Combination {
+	o := new(Combination)
+	o.f = maths.NewVector()
+	return o
+}
+
+// NewPrioritized constructs a combination that gives the agent's max
+// force to its behaviours in the order added. Later behaviours only get
+// what earlier ones leave.
+func NewPrioritized() *Combination {
+	o := NewWeighted()
+	o.prioritized = true
+	return o
+}
+
+// Add appends a behaviour with a weight
+func (c *Combination) Add(behavior api.ISteeringBehavior, weight float64) *Combination {
+	c.behaviors = append(c.behaviors, weightedBehavior{behavior: behavior, weight: weight})
+	return c
+}
+
+// Steer sets force to the combined force, limited to the agent's max
+// force.
+func (c *Combination) Steer(agent api.ISteeringAgent, force api.IVector) {
+	force.SetByComp(0.0, 0.0)
+	max := agent.MaxForce()
+
+	for _, b := range c.behaviors {
+		b.behavior.Steer(agent, c.f)
+		c.f.Scale(b.weight)
+
+		if c.prioritized {
+			remaining := max - force.Length()
+			if remaining <= 0.0 {
+				break
+			}
+			truncate(c.f, remaining)
+		}
+
+		force.AddV(c.f)
+	}
+
+	truncate(force, max)
+}
+
+var steer = maths.NewVector()
+
+// Update steers an agent by a behaviour for dt seconds.
+func Update(agent api.ISteeringAgent, behavior api.ISteeringBehavior, dt float64) {
+	behavior.Steer(agent, steer)
+	truncate(steer, agent.MaxForce())
+	agent.ApplyForce(steer, dt)
+}
+
+func truncate(v api.IVector, max float64) {
+	if l := v.Length(); l > max && l > 0.0 {
+		v.Scale(max / l)
+	}
+}
@@ -0,0 +1,140 @@
+package steering
+
+import (
+	"math"
+
+	"github.com/wdevore/RangerGo/api"
+)
+
+// Flock is a group of agents that steer relative to each other.
+type Flock struct {
+	agents []api.ISteeringAgent
+}
+
+// NewFlock constructs an empty flock
+func NewFlock() *Flock {
+	return new(Flock)
+}
+
+// Add adds an agent
+func (f *Flock) Add(agent api.ISteeringAgent) {
+	f.agents = append(f.agents, agent)
+}
+
+// Remove removes an agent
+func (f *Flock) Remove(agent api.ISteeringAgent) {
+	for i, a := range f.agents {
+		if a == agent {
+			f.agents = append(f.agents[:i], f.agents[i+1:]...)
+			return
+		}
+	}
+}
+
+// Agents returns the flock's agents
+func (f *Flock) Agents() []api.ISteeringAgent {
+	return f.agents
+}
+
+// neighbors calls visit for each other agent within a radius.
+func (f *Flock) neighbors(agent api.ISteeringAgent, radius float64, visit func(other api.ISteeringAgent, dx, dy, d float64)) int {
+	pos := agent.Position()
+	count := 0
+
+	for _, other := range f.agents {
+		if other == agent {
+			continue
+		}
+
+		op := other.Position()
+		dx, dy := pos.X()-op.X(), pos.Y()-op.Y()
+		if d := math.Hypot(dx, dy); d < radius {
+			visit(other, dx, dy, d)
+			count++
+		}
+	}
+
+	return count
+}
+
+type separationBehavior struct {
+	flock  *Flock
+	radius float64
+}
+
+// Separation steers away from flock mates within a radius, more so
+// from closer ones.
+func Separation(flock *Flock, radius float64) api.ISteeringBehavior {
+	return &separationBehavior{flock: flock, radius: radius}
+}
+
+func (b *separationBehavior) Steer(agent api.ISteeringAgent, force api.IVector) {
+	sx, sy := 0.0, 0.0
+	count := b.flock.neighbors(agent, b.radius, func(other api.ISteeringAgent, dx, dy, d float64) {
+		if d > 0.0 {
+			// Weighted by the inverse distance
+			sx += dx / d / d
+			sy += dy / d / d
+		}
+	})
+
+	if count == 0 || (sx == 0.0 && sy == 0.0) {
+		force.SetByComp(0.0, 0.0)
+		return
+	}
+
+	desired(agent, sx, sy, maxSpeed(agent), force)
+}
+
+type alignmentBehavior struct {
+	flock  *Flock
+	radius float64
+}
+
+// Alignment steers toward the average heading of flock mates within a
+// radius.
+func Alignment(flock *Flock, radius float64) api.ISteeringBehavior {
+	return &alignmentBehavior{flock: flock, radius: radius}
+}
+
+func (b *alignmentBehavior) Steer(agent api.ISteeringAgent, force api.IVector) {
+	hx, hy := 0.0, 0.0
+	count := b.flock.neighbors(agent, b.radius, func(other api.ISteeringAgent, dx, dy, d float64) {
+		dir := other.Velocity().Direction()
+		hx += dir.X()
+		hy += dir.Y()
+	})
+
+	if count == 0 || (hx == 0.0 && hy == 0.0) {
+		force.SetByComp(0.0, 0.0)
+		return
+	}
+
+	desired(agent, hx, hy, maxSpeed(agent), force)
+}
+
+type cohesionBehavior struct {
+	flock  *Flock
+	radius float64
+}
+
+// Cohesion seeks the center of flock mates within a radius.
+func Cohesion(flock *Flock, radius float64) api.ISteeringBehavior {
+	return &cohesionBehavior{flock: flock, radius: radius}
+}
+
+func (b *cohesionBehavior) Steer(agent api.ISteeringAgent, force api.IVector) {
+	cx, cy := 0.0, 0.0
+	count := b.flock.neighbors(agent, b.radius, func(other api.ISteeringAgent, dx, dy, d float64) {
+		p := other.Position()
+		cx += p.X()
+		cy += p.Y()
+	})
+
+	if count == 0 {
+		force.SetByComp(0.0, 0.0)
+		return
+	}
+
+	seek(agent, cx/float64(count), cy/float64(count), force)
+}
@@ -0,0 +1,197 @@
+package actions
+
+import (
+	"github.com/wdevore/RangerGo/api"
+)
+
+// --------------------------------------------------------
+// Call
+// --------------------------------------------------------
+
+type call struct {
+	fn   func(target api.INode)
+	done bool
+
+	target api.INode
+}
+
+// Call calls a function, with the action's node, and finishes immediately
+func Call(fn func(target api.INode)) api.IAction {
+	o := new(call)
+	o.fn = fn
+	return o
+}
+
+func (a *call) Start(target api.INode) {
+	a.target = target
+	a.done = false
+}
+
+func (a *call) Update(dt float64) float64 {
+	if !a.done {
+		a.done = true
+		a.fn(a.target)
+	}
+	return dt
+}
+
+func (a *call) IsDone() bool {
+	return a.done
+}
+
+// --------------------------------------------------------
+// Sequence
+// --------------------------------------------------------
+
+type sequence struct {
+	actions []api.IAction
+	current int
+
+	target api.INode
+}
+
+// Sequence runs actions one after the other
+func Sequence(actions ...api.IAction) api.IAction {
+	o := new(sequence)
+	o.actions = actions
+	return o
+}
+
+func (a *sequence) Start(target api.INode) {
+	a.target = target
+	a.current = 0
+
+	if len(a.actions) > 0 {
+		a.actions[0].Start(target)
+	}
+}
+
+func (a *sequence) Update(dt float64) float64 {
+	// Time left over by an action is passed to the next one.
+	for a.current < len(a.actions) {
+		dt = a.actions[a.current].Update(dt)
+		if !a.actions[a.current].IsDone() {
+			return 0.0
+		}
+
+		a.current++
+		if a.current < len(a.actions) {
+			a.actions[a.current].Start(a.target)
+		}
+	}
+
+	return dt
+}
+
+func (a *sequence) IsDone() bool {
+	return a.current >= len(a.actions)
+}
+
+// --------------------------------------------------------
+// Parallel
+// --------------------------------------------------------
+
+type parallel struct {
+	actions []api.IAction
+}
+
+// Parallel runs actions together and finishes when all have
+func Parallel(actions ...api.IAction) api.IAction {
+	o := new(parallel)
+	o.actions = actions
+	return o
+}
+
+func (a *parallel) Start(target api.INode) {
+	for _, action := range a.actions {
+		action.Start(target)
+	}
+}
+
+func (a *parallel) Update(dt float64) float64 {
+	// The left over time is what the longest action didn't use.
+	left := dt
+	for _, action := range a.actions {
+		if !action.IsDone() {
+			if l := action.Update(dt); l < left {
+				left = l
+			}
+		}
+	}
+
+	if !a.IsDone() {
+		return 0.0
+	}
+
+	return left
+}
+
+func (a *parallel) IsDone() bool {
+	for _, action := range a.actions {
+		if !action.IsDone() {
+			return false
+		}
+	}
+	return true
+}
+
+// --------------------------------------------------------
+// Repeat
+// --------------------------------------------------------
+
+type repeat struct {
+	action api.IAction
+	// times <= 0 repeats forever
+	times int
+	count int
+
+	target api.INode
+}
+
+// Repeat runs an action a number of times
+func Repeat(times int, action api.IAction) api.IAction {
+	o := new(repeat)
+	o.action = action
+	o.times = times
+	return o
+}
+
+// RepeatForever runs an action until it is stopped
+func RepeatForever(action api.IAction) api.IAction {
+	return Repeat(0, action)
+}
+
+func (a *repeat) Start(target api.INode) {
+	a.target = target
+	a.count = 0
+	a.action.Start(target)
+}
+
+func (a *repeat) Update(dt float64) float64 {
+	for !a.IsDone() {
+		left := a.action.Update(dt)
+		if !a.action.IsDone() {
+			return 0.0
+		}
+
+		a.count++
+		if a.IsDone() {
+			return left
+		}
+
+		a.action.Start(a.target)
+
+		// An action that takes no time would repeat forever within
+		// a single update.
+		if left >= dt {
+			return 0.0
+		}
+		dt = left
+	}
+
+	return dt
+}
+
+func (a *repeat) IsDone() bool {
+	return a.times > 0 && a.count >= a.times
+}
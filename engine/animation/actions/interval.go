@@ -0,0 +1,198 @@
+package actions
+
+import (
+	"math"
+
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine/maths"
+	"github.com/wdevore/RangerGo/engine/rendering"
+)
+
+// interval is an action that changes a node over a duration. begin
+// captures the node's starting state and apply sets the node's state
+// at a normalized (0.0 -> 1.0) time.
+type interval struct {
+	duration float64
+	elapsed  float64
+	done     bool
+
+	equation api.ITweenEquation
+
+	target api.INode
+
+	begin func(target api.INode)
+	apply func(target api.INode, t float64)
+}
+
+func newInterval(duration float64, begin func(api.INode), apply func(api.INode, float64)) *interval {
+	o := new(interval)
+	o.duration = duration
+	o.begin = begin
+	o.apply = apply
+	return o
+}
+
+func (a *interval) Start(target api.INode) {
+	a.target = target
+	a.elapsed = 0.0
+	a.done = false
+
+	if a.begin != nil {
+		a.begin(target)
+	}
+}
+
+func (a *interval) Update(dt float64) float64 {
+	if a.done {
+		return dt
+	}
+
+	a.elapsed += dt
+
+	t := 1.0
+	if a.duration > 0.0 {
+		t = math.Min(a.elapsed/a.duration, 1.0)
+	}
+
+	if a.equation != nil {
+		t = a.equation.Compute(t)
+	}
+
+	if a.apply != nil {
+		a.apply(a.target, t)
+	}
+
+	if a.elapsed < a.duration {
+		return 0.0
+	}
+
+	a.done = true
+	return a.elapsed - a.duration
+}
+
+func (a *interval) IsDone() bool {
+	return a.done
+}
+
+// Ease applies an easing equation to a timed action, for example,
+// MoveTo. Composite actions can't be eased.
+func Ease(equation api.ITweenEquation, action api.IAction) api.IAction {
+	if a, ok := action.(*interval); ok {
+		a.equation = equation
+	}
+	return action
+}
+
+// Delay waits for a duration, in milliseconds
+func Delay(duration float64) api.IAction {
+	return newInterval(duration, nil, nil)
+}
+
+// MoveTo moves a node to a position
+func MoveTo(duration, x, y float64) api.IAction {
+	var fromX, fromY float64
+
+	return newInterval(duration,
+		func(target api.INode) {
+			fromX, fromY = target.Position().Components()
+		},
+		func(target api.INode, t float64) {
+			target.SetPosition(maths.Lerp(fromX, x, t), maths.Lerp(fromY, y, t))
+		})
+}
+
+// MoveBy moves a node relative to its position when started
+func MoveBy(duration, dx, dy float64) api.IAction {
+	var fromX, fromY float64
+
+	return newInterval(duration,
+		func(target api.INode) {
+			fromX, fromY = target.Position().Components()
+		},
+		func(target api.INode, t float64) {
+			target.SetPosition(fromX+dx*t, fromY+dy*t)
+		})
+}
+
+// RotateTo rotates a node to an angle, in radians
+func RotateTo(duration, radians float64) api.IAction {
+	var from float64
+
+	return newInterval(duration,
+		func(target api.INode) {
+			from = target.Rotation()
+		},
+		func(target api.INode, t float64) {
+			target.SetRotation(maths.Lerp(from, radians, t))
+		})
+}
+
+// RotateBy rotates a node relative to its angle when started
+func RotateBy(duration, radians float64) api.IAction {
+	var from float64
+
+	return newInterval(duration,
+		func(target api.INode) {
+			from = target.Rotation()
+		},
+		func(target api.INode, t float64) {
+			target.SetRotation(from + radians*t)
+		})
+}
+
+// ScaleTo scales a node
+func ScaleTo(duration, scale float64) api.IAction {
+	var from float64
+
+	return newInterval(duration,
+		func(target api.INode) {
+			from = target.Scale()
+		},
+		func(target api.INode, t float64) {
+			target.SetScale(maths.Lerp(from, scale, t))
+		})
+}
+
+// FadeTo changes a node's opacity
+func FadeTo(duration, opacity float64) api.IAction {
+	var from float64
+
+	return newInterval(duration,
+		func(target api.INode) {
+			from = target.Opacity()
+		},
+		func(target api.INode, t float64) {
+			target.SetOpacity(maths.Lerp(from, opacity, t))
+		})
+}
+
+// TintTo changes the color of an api.IColorable node. The node is
+// given its own palette so shared palettes aren't changed.
+func TintTo(duration float64, color api.IPalette) api.IAction {
+	var from, tint api.IPalette
+
+	return newInterval(duration,
+		func(target api.INode) {
+			colorable, ok := target.(api.IColorable)
+			if !ok {
+				from = nil
+				return
+			}
+			from = colorable.Color()
+			tint = rendering.NewPaletteInt64(from.AsUInt64())
+			colorable.SetColor(tint)
+		},
+		func(target api.INode, t float64) {
+			if from == nil {
+				return
+			}
+			tint.SetRed(lerpComponent(from.R(), color.R(), t))
+			tint.SetGreen(lerpComponent(from.G(), color.G(), t))
+			tint.SetBlue(lerpComponent(from.B(), color.B(), t))
+			tint.SetAlpha(lerpComponent(from.A(), color.A(), t))
+		})
+}
+
+func lerpComponent(from, to uint8, t float64) int {
+	return int(math.Round(maths.Lerp(float64(from), float64(to), t)))
+}
@@ -0,0 +1,40 @@
+package actions
+
+import (
+	"math"
+
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine/geometry"
+	"github.com/wdevore/RangerGo/engine/maths"
+)
+
+// FollowPath moves a node along a path, in its parent's space, at a
+// speed in units per second. With orient the node is rotated to the
+// path's tangent. Wrap it in RepeatForever to patrol a closed path.
+func FollowPath(path api.IPath, speed float64, orient bool) api.IAction {
+	p := geometry.NewPoint()
+	tangent := maths.NewVector()
+
+	var a *interval
+	a = newInterval(0.0,
+		func(target api.INode) {
+			// The path may have been rebuilt since the action was made.
+			a.duration = 0.0
+			if speed > 0.0 {
+				a.duration = path.Length() / speed * 1000.0
+			}
+		},
+		func(target api.INode, t float64) {
+			distance := path.Length() * t
+
+			path.PointAt(distance, p)
+			target.SetPosition(p.X(), p.Y())
+
+			if orient {
+				path.TangentAt(distance, tangent)
+				target.SetRotation(math.Atan2(tangent.Y(), tangent.X()))
+			}
+		})
+
+	return a
+}
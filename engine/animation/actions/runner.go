@@ -0,0 +1,83 @@
+package actions
+
+import (
+	"github.com/wdevore/RangerGo/api"
+)
+
+type running struct {
+	target  api.INode
+	action  api.IAction
+	stopped bool
+}
+
+type runner struct {
+	actions []*running
+}
+
+// NewRunner constructs an IActionRunner
+func NewRunner() api.IActionRunner {
+	o := new(runner)
+	return o
+}
+
+func (r *runner) Run(target api.INode, action api.IAction) {
+	action.Start(target)
+	r.actions = append(r.actions, &running{target: target, action: action})
+}
+
+func (r *runner) Stop(target api.INode) {
+	for _, a := range r.actions {
+		if a.target == target {
+			a.stopped = true
+		}
+	}
+}
+
+func (r *runner) StopAction(action api.IAction) {
+	for _, a := range r.actions {
+		if a.action == action {
+			a.stopped = true
+		}
+	}
+}
+
+func (r *runner) IsRunning(target api.INode) bool {
+	for _, a := range r.actions {
+		if a.target == target && !a.stopped {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *runner) Update(msPerUpdate float64) {
+	// Actions can be run and stopped while updating, for example, by
+	// a Call. Actions run while updating start on the next update.
+	actions := r.actions
+
+	for _, a := range actions {
+		if a.stopped {
+			continue
+		}
+
+		a.action.Update(msPerUpdate)
+
+		if a.action.IsDone() {
+			a.stopped = true
+		}
+	}
+
+	remaining := r.actions[:0]
+	for _, a := range r.actions {
+		if !a.stopped {
+			remaining = append(remaining, a)
+		}
+	}
+
+	// Release the stopped actions
+	for i := len(remaining); i < len(r.actions); i++ {
+		r.actions[i] = nil
+	}
+
+	r.actions = remaining
+}
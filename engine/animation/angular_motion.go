@@ -1,11 +1,15 @@
 package animation
 
 import (
+	"math"
+
 	"github.com/wdevore/RangerGo/api"
 	"github.com/wdevore/RangerGo/engine/maths"
 )
 
-// AngularMotion represents rotational motion
+// AngularMotion represents rotational motion in radians. With
+// wrapping, the default, angles stay within 0 -> 2π and interpolation
+// takes the shortest path, for example, from 350° to 10° passes 0°.
 type AngularMotion struct {
 	from, to float64
 	angle    float64
@@ -23,6 +27,11 @@ func NewAngularMotion() api.IMotion {
 func (m *AngularMotion) Set(from, to interface{}) {
 	m.from = from.(float64)
 	m.to = to.(float64)
+
+	if m.autoWrap {
+		m.from = WrapAngle(m.from)
+		m.to = m.from + ShortestAngle(m.from, m.to)
+	}
 }
 
 // Interpolate performs interpolation between `from` and `to`
@@ -30,21 +39,7 @@ func (m *AngularMotion) Interpolate(t float64) interface{} {
 	m.angle = maths.Lerp(m.from, m.to, t)
 
 	if m.autoWrap {
-		if m.rate > 0.0 {
-			if m.angle >= 360 {
-				// Wrap range back around
-				m.from = 0.0
-				m.to = 361.0 - m.angle
-				// Calc new angle from the adjusted range
-				m.angle = maths.Lerp(m.from, m.to, t)
-			}
-		} else {
-			if m.angle <= 0.0 {
-				m.from = 360.0
-				m.to = 358.0 - m.angle
-				m.angle = maths.Lerp(m.from, m.to, t)
-			}
-		}
+		m.angle = WrapAngle(m.angle)
 	}
 
 	return m.angle
@@ -53,12 +48,33 @@ func (m *AngularMotion) Interpolate(t float64) interface{} {
 // Update sets a new time window that rendering passes will interpolate
 // between.
 // dt = milliseconds.
-// Only used for non-2D motion
 func (m *AngularMotion) Update(dt float64) {
 	// During each frame the "from" becomes the current "to"
 	m.from = m.to
 
 	// "to" is now moved to the next value
-	// divide by time_scale
-	m.to += m.rate * (dt / m.timeScale)
+	m.to += m.step(dt)
+
+	if m.autoWrap {
+		// Shift the window, rather than each end, so the window
+		// doesn't flip when "to" wraps.
+		turns := math.Floor(m.from / (2.0 * math.Pi))
+		m.from -= turns * 2.0 * math.Pi
+		m.to -= turns * 2.0 * math.Pi
+	}
+}
+
+// WrapAngle wraps radians into 0 -> 2π
+func WrapAngle(radians float64) float64 {
+	radians = math.Mod(radians, 2.0*math.Pi)
+	if radians < 0.0 {
+		radians += 2.0 * math.Pi
+	}
+	return radians
+}
+
+// ShortestAngle returns the smallest signed angle from one angle to
+// another, within -π -> π.
+func ShortestAngle(from, to float64) float64 {
+	return WrapAngle(to-from+math.Pi) - math.Pi
 }
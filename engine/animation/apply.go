@@ -0,0 +1,43 @@
+package animation
+
+import "github.com/wdevore/RangerGo/api"
+
+// Setters for INode.AddMotion, for example:
+//     node.AddMotion(motion, animation.ApplyRotation(node))
+
+// ApplyPosition sets the node's position from a Linear2DMotion.
+func ApplyPosition(node api.INode) func(value interface{}) {
+	return func(value interface{}) {
+		v := value.(api.IVector)
+		node.SetPosition(v.X(), v.Y())
+	}
+}
+
+// ApplyRotation sets the node's rotation from an AngularMotion.
+func ApplyRotation(node api.INode) func(value interface{}) {
+	return func(value interface{}) {
+		node.SetRotation(value.(float64))
+	}
+}
+
+// ApplyScale sets the node's scale from a LinearMotion.
+func ApplyScale(node api.INode) func(value interface{}) {
+	return func(value interface{}) {
+		node.SetScale(value.(float64))
+	}
+}
+
+// ApplyOpacity sets the node's opacity from a LinearMotion.
+func ApplyOpacity(node api.INode) func(value interface{}) {
+	return func(value interface{}) {
+		node.SetOpacity(value.(float64))
+	}
+}
+
+// ApplyColor sets the node's color from a ColorMotion. The motion's
+// palette is shared with the node.
+func ApplyColor(node api.IColorable) func(value interface{}) {
+	return func(value interface{}) {
+		node.SetColor(value.(api.IPalette))
+	}
+}
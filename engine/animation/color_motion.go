@@ -0,0 +1,78 @@
+package animation
+
+import (
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine/maths"
+	"github.com/wdevore/RangerGo/engine/rendering"
+)
+
+// ColorMotion fades between two colors. The rate is the fraction of the
+// fade per second, for example, 0.5 takes 2 seconds. With wrapping,
+// the default, the fade restarts once complete, otherwise it holds.
+type ColorMotion struct {
+	from, to api.IPalette
+	color    api.IPalette
+
+	// Fade progress window, 0.0 -> 1.0
+	previous, current float64
+
+	Motion
+}
+
+// NewColorMotion create a color motion object
+func NewColorMotion() api.IMotion {
+	o := new(ColorMotion)
+	o.InitializeMotion()
+	o.from = rendering.NewPalette()
+	o.to = rendering.NewPalette()
+	o.color = rendering.NewPalette()
+	return o
+}
+
+// Set copies the colors to fade between and restarts the fade
+func (m *ColorMotion) Set(from, to interface{}) {
+	copyPalette(from.(api.IPalette), m.from)
+	copyPalette(to.(api.IPalette), m.to)
+	m.previous = 0.0
+	m.current = 0.0
+}
+
+// Interpolate returns the color within the window. The palette is
+// reused.
+func (m *ColorMotion) Interpolate(t float64) interface{} {
+	p := maths.Lerp(m.previous, m.current, t)
+
+	m.color.SetRed(lerpComponent(m.from.R(), m.to.R(), p))
+	m.color.SetGreen(lerpComponent(m.from.G(), m.to.G(), p))
+	m.color.SetBlue(lerpComponent(m.from.B(), m.to.B(), p))
+	m.color.SetAlpha(lerpComponent(m.from.A(), m.to.A(), p))
+
+	return m.color
+}
+
+// Update sets a new time window that rendering passes will interpolate
+// between.
+// dt = milliseconds.
+func (m *ColorMotion) Update(dt float64) {
+	if m.current >= 1.0 {
+		if !m.autoWrap {
+			m.previous = 1.0
+			return
+		}
+		m.current = 0.0
+	}
+
+	m.previous = m.current
+	m.current = maths.Clamp(m.current+m.step(dt), 0.0, 1.0)
+}
+
+func copyPalette(from, to api.IPalette) {
+	to.SetRed(int(from.R()))
+	to.SetGreen(int(from.G()))
+	to.SetBlue(int(from.B()))
+	to.SetAlpha(int(from.A()))
+}
+
+func lerpComponent(from, to uint8, t float64) int {
+	return int(maths.Lerp(float64(from), float64(to), t) + 0.5)
+}
@@ -5,10 +5,12 @@ import (
 	"github.com/wdevore/RangerGo/engine/maths"
 )
 
-// Linear2DMotion represents vector interpolations
+// Linear2DMotion represents vector interpolations. The motion moves
+// along its direction at its rate. Wrapping isn't used.
 type Linear2DMotion struct {
-	from, to api.IVector
-	p        api.IVector
+	from, to  api.IVector
+	direction api.IVector
+	p         api.IVector
 
 	Motion
 }
@@ -17,33 +19,41 @@ type Linear2DMotion struct {
 func NewLinear2DMotion() api.IMotion {
 	o := new(Linear2DMotion)
 	o.InitializeMotion()
+	o.from = maths.NewVector()
+	o.to = maths.NewVector()
+	o.direction = maths.NewVectorUsing(1.0, 0.0)
 	o.p = maths.NewVector()
 	return o
 }
 
-// Set sets `from` and `to`
+// Set copies `from` and `to`
 func (m *Linear2DMotion) Set(from, to interface{}) {
-	m.from = from.(api.IVector)
-	m.to = to.(api.IVector)
+	m.from.SetByVector(from.(api.IVector))
+	m.to.SetByVector(to.(api.IVector))
+}
+
+// SetDirection sets the direction of motion. It is normalized.
+func (m *Linear2DMotion) SetDirection(x, y float64) {
+	m.direction.SetByComp(x, y)
+	m.direction.Normalize()
 }
 
 // Interpolate performs interpolation between `from` and `to`
 func (m *Linear2DMotion) Interpolate(t float64) interface{} {
-	maths.LerpVectors(m.from, m.to, m.p, t)
+	m.p.SetByComp(
+		maths.Lerp(m.from.X(), m.to.X(), t),
+		maths.Lerp(m.from.Y(), m.to.Y(), t))
 	return m.p
 }
 
 // Update sets a new time window that rendering passes will interpolate
 // between.
 // dt = milliseconds.
-// Only used for non-2D motion
-// ###### This may not be correct AND hasn't been tested #######
 func (m *Linear2DMotion) Update(dt float64) {
 	// During each frame the "from" becomes the current "to"
-	m.from = m.to
+	m.from.SetByVector(m.to)
 
-	// "to" is now moved to the next value
-	// divide by time_scale
-	n := m.rate * (dt / m.timeScale)
-	m.to.Add(n*m.to.X(), n*m.to.Y())
+	// "to" is now moved along the direction
+	s := m.step(dt)
+	m.to.Add(m.direction.X()*s, m.direction.Y()*s)
 }
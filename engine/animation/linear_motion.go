@@ -5,10 +5,11 @@ import (
 	"github.com/wdevore/RangerGo/engine/maths"
 )
 
-// LinearMotion represents zero dimension interpolations
+// LinearMotion represents zero dimension interpolations, for example,
+// scale. Wrapping isn't used.
 type LinearMotion struct {
 	from, to float64
-	angle    float64
+	value    float64
 	Motion
 }
 
@@ -27,19 +28,17 @@ func (m *LinearMotion) Set(from, to interface{}) {
 
 // Interpolate performs interpolation between `from` and `to`
 func (m *LinearMotion) Interpolate(t float64) interface{} {
-	m.angle = maths.Lerp(m.from, m.to, t)
-	return m.angle
+	m.value = maths.Lerp(m.from, m.to, t)
+	return m.value
 }
 
 // Update sets a new time window that rendering passes will interpolate
 // between.
 // dt = milliseconds.
-// Only used for non-2D motion
 func (m *LinearMotion) Update(dt float64) {
 	// During each frame the "from" becomes the current "to"
 	m.from = m.to
 
 	// "to" is now moved to the next value
-	// divide by time_scale
-	m.to += m.rate * (dt / m.timeScale)
+	m.to += m.step(dt)
 }
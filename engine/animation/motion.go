@@ -1,7 +1,11 @@
 package animation
 
-// Motion are properties related to interpolation
+// Motion are properties related to interpolation. Each fixed update a
+// motion moves its time window: the previous "to" becomes "from" and
+// "to" advances by the rate. Rendering passes interpolate within the
+// window.
 type Motion struct {
+	// rate is the change per timeScale, for example, radians per second
 	rate      float64
 	timeScale float64
 
@@ -30,3 +34,8 @@ func (m *Motion) SetAutoWrap(wrap bool) {
 func (m *Motion) SetTimeScale(s float64) {
 	m.timeScale = s
 }
+
+// step is how far the rate moves in dt milliseconds
+func (m *Motion) step(dt float64) float64 {
+	return m.rate * (dt / m.timeScale)
+}
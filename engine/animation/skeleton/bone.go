@@ -0,0 +1,59 @@
+package skeleton
+
+import (
+	"fmt"
+
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine/nodes"
+)
+
+// Bone is an api.IBone. Bones draw nothing themselves.
+type Bone struct {
+	nodes.Node
+
+	restX, restY, restRotation float64
+	length                     float64
+}
+
+// NewBone constructs a bone node at rest at the parent's origin
+func NewBone(name string, world api.IWorld, parent api.INode) api.INode {
+	o := new(Bone)
+	o.Initialize(name)
+	o.SetParent(parent)
+	parent.AddChild(o)
+	o.Build(world)
+	return o
+}
+
+// SetRest sets the rest transform and poses the bone at rest
+func (b *Bone) SetRest(x, y, radians float64) {
+	b.restX = x
+	b.restY = y
+	b.restRotation = radians
+	b.ResetPose()
+}
+
+// RestRotation returns the rest rotation
+func (b *Bone) RestRotation() float64 {
+	return b.restRotation
+}
+
+// ResetPose returns the bone to its rest transform
+func (b *Bone) ResetPose() {
+	b.SetPosition(b.restX, b.restY)
+	b.SetRotation(b.restRotation)
+}
+
+// SetLength sets the bone's length
+func (b *Bone) SetLength(length float64) {
+	b.length = length
+}
+
+// Length returns the bone's length
+func (b *Bone) Length() float64 {
+	return b.length
+}
+
+func (b Bone) String() string {
+	return fmt.Sprintf("%s length: %0.2f", b.Node, b.length)
+}
@@ -0,0 +1,85 @@
+package skeleton
+
+import (
+	"math"
+	"sort"
+
+	"github.com/wdevore/RangerGo/engine/maths"
+)
+
+type rotationKey struct {
+	time    float64
+	radians float64
+}
+
+// Clip is a set of keyframed bone rotations. Rotations are relative
+// to each bone's rest rotation and are interpolated linearly.
+type Clip struct {
+	duration float64
+	loop     bool
+	tracks   map[string][]rotationKey
+}
+
+// NewClip constructs a looping clip lasting duration milliseconds
+func NewClip(duration float64) *Clip {
+	o := new(Clip)
+	o.duration = duration
+	o.loop = true
+	o.tracks = map[string][]rotationKey{}
+	return o
+}
+
+// AddKey adds a rotation for a named bone at a time in milliseconds
+func (c *Clip) AddKey(bone string, time, radians float64) {
+	keys := append(c.tracks[bone], rotationKey{time: time, radians: radians})
+	sort.SliceStable(keys, func(i, j int) bool { return keys[i].time < keys[j].time })
+	c.tracks[bone] = keys
+}
+
+// SetLoop sets whether the clip repeats, the default, or holds its
+// last pose.
+func (c *Clip) SetLoop(loop bool) {
+	c.loop = loop
+}
+
+// Duration returns the clip's length in milliseconds
+func (c *Clip) Duration() float64 {
+	return c.duration
+}
+
+// Sample returns a bone's rotation at a time. It returns false if the
+// clip doesn't animate the bone.
+func (c *Clip) Sample(bone string, time float64) (radians float64, ok bool) {
+	keys, ok := c.tracks[bone]
+	if !ok || len(keys) == 0 {
+		return 0.0, false
+	}
+
+	time = c.wrap(time)
+
+	if time <= keys[0].time {
+		return keys[0].radians, true
+	}
+
+	for i := 1; i < len(keys); i++ {
+		if time < keys[i].time {
+			from, to := keys[i-1], keys[i]
+			t := (time - from.time) / (to.time - from.time)
+			return maths.Lerp(from.radians, to.radians, t), true
+		}
+	}
+
+	return keys[len(keys)-1].radians, true
+}
+
+func (c *Clip) wrap(time float64) float64 {
+	if c.duration <= 0.0 {
+		return 0.0
+	}
+
+	if c.loop {
+		return math.Mod(time, c.duration)
+	}
+
+	return math.Min(time, c.duration)
+}
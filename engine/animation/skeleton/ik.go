@@ -0,0 +1,103 @@
+package skeleton
+
+import (
+	"math"
+
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine/animation"
+	"github.com/wdevore/RangerGo/engine/geometry"
+	"github.com/wdevore/RangerGo/engine/maths"
+	"github.com/wdevore/RangerGo/engine/nodes"
+)
+
+// TwoBoneIK rotates a bone and its child bone so the child's tip
+// reaches a target node, for example, an arm reaching for the mouse.
+// The bones aren't expected to be scaled.
+type TwoBoneIK struct {
+	upper, lower api.IBone
+	target       api.INode
+
+	// bend is 1.0 or -1.0 and picks which side the joint bends to
+	bend   float64
+	weight float64
+
+	p api.IPoint
+}
+
+// NewTwoBoneIK constructs a solver for a bone and its child bone
+func NewTwoBoneIK(upper, lower api.IBone) *TwoBoneIK {
+	o := new(TwoBoneIK)
+	o.upper = upper
+	o.lower = lower
+	o.bend = 1.0
+	o.weight = 1.0
+	o.p = geometry.NewPoint()
+	return o
+}
+
+// SetTarget sets the node to reach for. A nil target disables the
+// solver.
+func (k *TwoBoneIK) SetTarget(target api.INode) {
+	k.target = target
+}
+
+// SetBendPositive picks which side the joint bends to. Positive, the
+// default, turns the lower bone by a positive rotation, i.e. the joint
+// sits on the negative rotation side of the line to the target.
+func (k *TwoBoneIK) SetBendPositive(positive bool) {
+	if positive {
+		k.bend = 1.0
+	} else {
+		k.bend = -1.0
+	}
+}
+
+// SetWeight blends from the animated pose (0.0) to the solved pose
+// (1.0, the default).
+func (k *TwoBoneIK) SetWeight(weight float64) {
+	k.weight = maths.Clamp(weight, 0.0, 1.0)
+}
+
+// Solve rotates the bones toward the target. Out of reach targets are
+// pointed at with the bones straightened.
+func (k *TwoBoneIK) Solve() {
+	if k.target == nil || k.weight == 0.0 || !k.upper.HasParent() {
+		return
+	}
+
+	// Work in the upper bone's parent-space.
+	nodes.MapNodeToNode(k.target, k.upper.Parent(), k.p, nil)
+
+	base := k.upper.Position()
+	dx := k.p.X() - base.X()
+	dy := k.p.Y() - base.Y()
+
+	// The lower bone may be offset from the upper bone's x-axis.
+	joint := k.lower.Position()
+	l1 := math.Hypot(joint.X(), joint.Y())
+	l2 := k.lower.Length()
+	offset := math.Atan2(joint.Y(), joint.X())
+
+	if l1 == 0.0 || l2 == 0.0 {
+		return
+	}
+
+	d := maths.Clamp(math.Hypot(dx, dy), math.Abs(l1-l2), l1+l2)
+	if d == 0.0 {
+		return
+	}
+
+	// Law of cosines for the angles at the base and the joint
+	alpha := math.Acos(maths.Clamp((l1*l1+d*d-l2*l2)/(2.0*l1*d), -1.0, 1.0))
+	beta := math.Acos(maths.Clamp((l1*l1+l2*l2-d*d)/(2.0*l1*l2), -1.0, 1.0))
+
+	upper := math.Atan2(dy, dx) - k.bend*alpha - offset
+	lower := k.bend*(math.Pi-beta) + offset
+
+	k.upper.SetRotation(k.blend(k.upper.Rotation(), upper))
+	k.lower.SetRotation(k.blend(k.lower.Rotation(), lower))
+}
+
+func (k *TwoBoneIK) blend(from, to float64) float64 {
+	return from + animation.ShortestAngle(from, to)*k.weight
+}
@@ -0,0 +1,112 @@
+package skeleton
+
+import (
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine/maths"
+	"github.com/wdevore/RangerGo/engine/nodes"
+)
+
+// Skeleton poses the bones beneath a root node. It plays a clip,
+// optionally blended with a second clip, and then applies any IK
+// solvers. Call Update from a node's Update.
+type Skeleton struct {
+	bones []api.IBone
+
+	clip, blend         *Clip
+	clipTime, blendTime float64
+	weight              float64
+	solvers             []*TwoBoneIK
+}
+
+// NewSkeleton collects the bones beneath, and including, the root
+func NewSkeleton(root api.INode) *Skeleton {
+	o := new(Skeleton)
+
+	collect := func(node api.INode) bool {
+		if bone, ok := node.(api.IBone); ok {
+			o.bones = append(o.bones, bone)
+		}
+		return true
+	}
+
+	collect(root)
+	nodes.Walk(root, collect)
+
+	return o
+}
+
+// Bone returns a bone by name, or nil
+func (s *Skeleton) Bone(name string) api.IBone {
+	for _, bone := range s.bones {
+		if bone.Name() == name {
+			return bone
+		}
+	}
+	return nil
+}
+
+// Bones returns the skeleton's bones, parents before children.
+func (s *Skeleton) Bones() []api.IBone {
+	return s.bones
+}
+
+// Play starts a clip from its beginning and removes any blend.
+func (s *Skeleton) Play(clip *Clip) {
+	s.clip = clip
+	s.clipTime = 0.0
+	s.blend = nil
+	s.weight = 0.0
+}
+
+// Blend mixes a second clip into the playing clip by a weight, where
+// 0.0 is only the playing clip and 1.0 is only the blended clip. The
+// blended clip starts from its beginning unless it is already blended.
+func (s *Skeleton) Blend(clip *Clip, weight float64) {
+	if clip != s.blend {
+		s.blend = clip
+		s.blendTime = 0.0
+	}
+	s.SetWeight(weight)
+}
+
+// SetWeight changes the blend weight
+func (s *Skeleton) SetWeight(weight float64) {
+	s.weight = maths.Clamp(weight, 0.0, 1.0)
+}
+
+// Weight returns the blend weight
+func (s *Skeleton) Weight() float64 {
+	return s.weight
+}
+
+// AddIK adds a solver. Solvers are applied in the order added.
+func (s *Skeleton) AddIK(ik *TwoBoneIK) {
+	s.solvers = append(s.solvers, ik)
+}
+
+// Update advances the clips by dt milliseconds and poses the bones.
+// Each bone is rotated to its rest rotation plus the clips' rotation,
+// zero for bones the clips don't animate.
+func (s *Skeleton) Update(dt float64) {
+	s.clipTime += dt
+	s.blendTime += dt
+
+	for _, bone := range s.bones {
+		from := s.sample(s.clip, bone.Name(), s.clipTime)
+		to := s.sample(s.blend, bone.Name(), s.blendTime)
+
+		bone.SetRotation(bone.RestRotation() + maths.Lerp(from, to, s.weight))
+	}
+
+	for _, ik := range s.solvers {
+		ik.Solve()
+	}
+}
+
+func (s *Skeleton) sample(clip *Clip, bone string, time float64) float64 {
+	if clip == nil {
+		return 0.0
+	}
+	radians, _ := clip.Sample(bone, time)
+	return radians
+}
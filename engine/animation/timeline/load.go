@@ -0,0 +1,174 @@
+package timeline
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"strings"
+
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine/animation/tweening"
+)
+
+// The file format is a set of named timelines:
+//
+//	{
+//	  "timelines": {
+//	    "zoom-in": {
+//	      "loop": false,
+//	      "tracks": [
+//	        {"node": "Zone", "property": "scale", "keys": [
+//	          {"time": 0},
+//	          {"time": 1000, "value": [3.0], "ease": "quad-inout"}
+//	        ]}
+//	      ],
+//	      "markers": [{"time": 1000, "name": "zoomed"}]
+//	    }
+//	  }
+//	}
+//
+// Keyframes without a value take the property's value when played.
+// Eases are "linear", "step", or an equation and style, for example,
+// "bounce-out". A "bezier" of [x1, y1, x2, y2] is a custom curve.
+
+type fileData struct {
+	Timelines map[string]timelineData `json:"timelines"`
+}
+
+type timelineData struct {
+	Duration float64      `json:"duration"`
+	Loop     bool         `json:"loop"`
+	Tracks   []trackData  `json:"tracks"`
+	Markers  []markerData `json:"markers"`
+}
+
+type trackData struct {
+	Node     string    `json:"node"`
+	Property string    `json:"property"`
+	Keys     []keyData `json:"keys"`
+}
+
+type keyData struct {
+	Time   float64   `json:"time"`
+	Value  []float64 `json:"value"`
+	Ease   string    `json:"ease"`
+	Bezier []float64 `json:"bezier"`
+}
+
+type markerData struct {
+	Time float64 `json:"time"`
+	Name string  `json:"name"`
+}
+
+var equations = map[string]int{
+	"linear":  api.EquationLinear,
+	"quad":    api.EquationQuad,
+	"cubic":   api.EquationCubic,
+	"quart":   api.EquationQuart,
+	"quint":   api.EquationQuint,
+	"sine":    api.EquationSine,
+	"expo":    api.EquationExpo,
+	"circ":    api.EquationCirc,
+	"back":    api.EquationBack,
+	"elastic": api.EquationElastic,
+	"bounce":  api.EquationBounce,
+}
+
+var styles = map[string]int{
+	"in":    api.EaseIn,
+	"out":   api.EaseOut,
+	"inout": api.EaseInOut,
+}
+
+// Load reads a file of named timelines
+func Load(path string) (map[string]*Timeline, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return Parse(data)
+}
+
+// Parse builds named timelines from JSON
+func Parse(data []byte) (map[string]*Timeline, error) {
+	file := fileData{}
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, err
+	}
+
+	timelines := map[string]*Timeline{}
+
+	for name, td := range file.Timelines {
+		t := NewTimeline()
+		t.SetDuration(td.Duration)
+		t.SetLoop(td.Loop)
+
+		for _, trd := range td.Tracks {
+			track := t.AddTrack(trd.Node, trd.Property)
+
+			for _, kd := range trd.Keys {
+				if err := addKey(track, kd); err != nil {
+					return nil, fmt.Errorf("timeline '%s', %s: %s", name, trd.Property, err)
+				}
+			}
+		}
+
+		for _, md := range td.Markers {
+			t.AddMarker(md.Time, md.Name)
+		}
+
+		timelines[name] = t
+	}
+
+	return timelines, nil
+}
+
+func addKey(track *Track, kd keyData) error {
+	if kd.Ease == "step" {
+		if kd.Value == nil {
+			return fmt.Errorf("step keyframe at %0.0fms needs a value", kd.Time)
+		}
+		track.AddStepKey(kd.Time, kd.Value...)
+		return nil
+	}
+
+	var ease api.ITweenEquation
+
+	if kd.Bezier != nil {
+		if len(kd.Bezier) != 4 {
+			return fmt.Errorf("bezier at %0.0fms needs 4 values", kd.Time)
+		}
+		ease = tweening.NewBezierEquation(kd.Bezier[0], kd.Bezier[1], kd.Bezier[2], kd.Bezier[3])
+	} else if kd.Ease != "" && kd.Ease != "linear" {
+		e, err := parseEase(kd.Ease)
+		if err != nil {
+			return err
+		}
+		ease = e
+	}
+
+	if kd.Value == nil {
+		track.AddCurrentKey(kd.Time, ease)
+		return nil
+	}
+
+	track.AddKey(kd.Time, ease, kd.Value...)
+	return nil
+}
+
+// parseEase parses "equation-style", for example, "quad-inout"
+func parseEase(name string) (api.ITweenEquation, error) {
+	parts := strings.Split(name, "-")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("unknown ease '%s'", name)
+	}
+
+	equation, ok := equations[parts[0]]
+	style, ok2 := styles[parts[1]]
+	if !ok || !ok2 {
+		return nil, fmt.Errorf("unknown ease '%s'", name)
+	}
+
+	return tweening.NewEquation(equation, style), nil
+}
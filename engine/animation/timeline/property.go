@@ -0,0 +1,96 @@
+package timeline
+
+import (
+	"math"
+	"sync"
+
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine/maths"
+)
+
+// Getter reads a property's values from a node
+type Getter func(node api.INode) []float64
+
+// Setter writes a property's values to a node
+type Setter func(node api.INode, values []float64)
+
+type property struct {
+	get Getter
+	set Setter
+
+	// color properties are RGBA components applied by the track to a
+	// palette it gives the node, rather than through set.
+	color bool
+}
+
+var (
+	propertiesMutex sync.RWMutex
+	properties      = map[string]*property{
+		"x": {
+			get: func(n api.INode) []float64 { return []float64{n.Position().X()} },
+			set: func(n api.INode, v []float64) { n.SetPosition(v[0], n.Position().Y()) },
+		},
+		"y": {
+			get: func(n api.INode) []float64 { return []float64{n.Position().Y()} },
+			set: func(n api.INode, v []float64) { n.SetPosition(n.Position().X(), v[0]) },
+		},
+		"position": {
+			get: func(n api.INode) []float64 { return []float64{n.Position().X(), n.Position().Y()} },
+			set: func(n api.INode, v []float64) { n.SetPosition(v[0], v[1]) },
+		},
+		"rotation": {
+			get: func(n api.INode) []float64 { return []float64{n.Rotation()} },
+			set: func(n api.INode, v []float64) { n.SetRotation(v[0]) },
+		},
+		"scale": {
+			get: func(n api.INode) []float64 { return []float64{n.Scale()} },
+			set: func(n api.INode, v []float64) { n.SetScale(v[0]) },
+		},
+		"opacity": {
+			get: func(n api.INode) []float64 { return []float64{n.Opacity()} },
+			set: func(n api.INode, v []float64) { n.SetOpacity(v[0]) },
+		},
+		"visible": {
+			get: func(n api.INode) []float64 {
+				if n.IsVisible() {
+					return []float64{1.0}
+				}
+				return []float64{0.0}
+			},
+			set: func(n api.INode, v []float64) { n.SetVisible(v[0] >= 0.5) },
+		},
+		"color": {get: getColor, color: true},
+	}
+)
+
+// RegisterProperty adds a property that tracks can animate, for
+// example, a custom node's zoom.
+func RegisterProperty(name string, get Getter, set Setter) {
+	propertiesMutex.Lock()
+	defer propertiesMutex.Unlock()
+
+	properties[name] = &property{get: get, set: set}
+}
+
+func findProperty(name string) *property {
+	propertiesMutex.RLock()
+	defer propertiesMutex.RUnlock()
+
+	return properties[name]
+}
+
+// Colors are RGBA components (0 -> 255) of api.IColorable nodes.
+func getColor(n api.INode) []float64 {
+	c, ok := n.(api.IColorable)
+	if !ok || c.Color() == nil {
+		return []float64{0.0, 0.0, 0.0, 0.0}
+	}
+
+	color := c.Color()
+	return []float64{float64(color.R()), float64(color.G()), float64(color.B()), float64(color.A())}
+}
+
+// component rounds and clamps as eased values can overshoot
+func component(v float64) int {
+	return int(math.Round(maths.Clamp(v, 0.0, 255.0)))
+}
@@ -0,0 +1,361 @@
+package timeline
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine/maths"
+	"github.com/wdevore/RangerGo/engine/nodes"
+	"github.com/wdevore/RangerGo/engine/rendering"
+)
+
+// Keyframe is a track's values at a time. The easing shapes the
+// change from the previous keyframe.
+type Keyframe struct {
+	time   float64
+	values []float64
+	ease   api.ITweenEquation
+	// step holds the previous values until the keyframe's time
+	step bool
+
+	// resolved are the values, or the property's values when played
+	resolved []float64
+}
+
+// Track animates one property of a node
+type Track struct {
+	path     string
+	property string
+	keys     []*Keyframe
+
+	node api.INode
+	prop *property
+
+	// palette is the node's own palette for color tracks
+	palette api.IPalette
+	// values is reused while interpolating
+	values []float64
+}
+
+type marker struct {
+	time float64
+	name string
+}
+
+// Timeline is an api.ITimeline
+type Timeline struct {
+	tracks  []*Track
+	markers []marker
+
+	duration float64
+	time     float64
+	playing  bool
+	loop     bool
+
+	listener api.ITimelineListener
+}
+
+// NewTimeline constructs an empty timeline
+func NewTimeline() *Timeline {
+	o := new(Timeline)
+	return o
+}
+
+// --------------------------------------------------------
+// Authoring
+// --------------------------------------------------------
+
+// AddTrack adds a track that animates a property, for example,
+// "position", of the node at path. An empty path is the bound node.
+func (t *Timeline) AddTrack(path, property string) *Track {
+	track := &Track{path: path, property: property}
+	t.tracks = append(t.tracks, track)
+	return track
+}
+
+// AddMarker adds a marker that is reported when passed
+func (t *Timeline) AddMarker(time float64, name string) {
+	t.markers = append(t.markers, marker{time, name})
+	sort.SliceStable(t.markers, func(i, j int) bool { return t.markers[i].time < t.markers[j].time })
+}
+
+// SetDuration extends the timeline beyond its last keyframe or marker
+func (t *Timeline) SetDuration(duration float64) {
+	t.duration = duration
+}
+
+// AddKey adds a keyframe. A nil ease is linear.
+func (k *Track) AddKey(time float64, ease api.ITweenEquation, values ...float64) *Track {
+	return k.add(&Keyframe{time: time, values: values, ease: ease})
+}
+
+// AddStepKey adds a keyframe whose values are applied without easing
+// once its time is reached, for example, for visibility.
+func (k *Track) AddStepKey(time float64, values ...float64) *Track {
+	return k.add(&Keyframe{time: time, values: values, step: true})
+}
+
+// AddCurrentKey adds a keyframe that takes the property's value when
+// the timeline plays, for example, to continue from where an
+// interrupted timeline left off.
+func (k *Track) AddCurrentKey(time float64, ease api.ITweenEquation) *Track {
+	return k.add(&Keyframe{time: time, ease: ease})
+}
+
+func (k *Track) add(key *Keyframe) *Track {
+	k.keys = append(k.keys, key)
+	sort.SliceStable(k.keys, func(i, j int) bool { return k.keys[i].time < k.keys[j].time })
+	return k
+}
+
+// --------------------------------------------------------
+// ITimeline
+// --------------------------------------------------------
+
+// Bind resolves the tracks' nodes relative to a node
+func (t *Timeline) Bind(root api.INode) {
+	for _, track := range t.tracks {
+		track.node = root
+		if track.path != "" {
+			track.node = nodes.FindByPath(root, track.path)
+		}
+		track.prop = findProperty(track.property)
+
+		if track.node == nil {
+			fmt.Println("Timeline: node not found: ", track.path)
+		}
+		if track.prop == nil {
+			fmt.Println("Timeline: unknown property: ", track.property)
+		}
+
+		track.validate()
+		track.resolve()
+	}
+}
+
+// Play starts the timeline from the beginning
+func (t *Timeline) Play() {
+	for _, track := range t.tracks {
+		track.resolve()
+	}
+
+	t.playing = true
+	t.time = 0.0
+
+	t.pass(0.0, 0.0, true)
+	t.apply()
+}
+
+// Stop pauses the timeline where it is
+func (t *Timeline) Stop() {
+	t.playing = false
+}
+
+// IsPlaying indicates if the timeline is playing
+func (t *Timeline) IsPlaying() bool {
+	return t.playing
+}
+
+// SetLoop makes the timeline restart when it reaches the end
+func (t *Timeline) SetLoop(loop bool) {
+	t.loop = loop
+}
+
+// Update advances a playing timeline
+func (t *Timeline) Update(dt float64) {
+	if !t.playing {
+		return
+	}
+
+	duration := t.Duration()
+	from := t.time
+	t.time += dt
+
+	if t.loop && duration > 0.0 {
+		for t.time >= duration {
+			t.pass(from, duration, false)
+			t.time -= duration
+			from = 0.0
+			t.pass(0.0, 0.0, true)
+		}
+	} else if t.time >= duration {
+		t.time = duration
+		t.playing = false
+	}
+
+	t.pass(from, t.time, false)
+	t.apply()
+}
+
+// Seek applies the state at a time without passing markers
+func (t *Timeline) Seek(time float64) {
+	t.time = maths.Clamp(time, 0.0, t.Duration())
+	t.apply()
+}
+
+// Time returns the timeline's position
+func (t *Timeline) Time() float64 {
+	return t.time
+}
+
+// Duration is the time of the last keyframe or marker, unless set
+func (t *Timeline) Duration() float64 {
+	duration := t.duration
+
+	for _, track := range t.tracks {
+		if n := len(track.keys); n > 0 && track.keys[n-1].time > duration {
+			duration = track.keys[n-1].time
+		}
+	}
+
+	if n := len(t.markers); n > 0 && t.markers[n-1].time > duration {
+		duration = t.markers[n-1].time
+	}
+
+	return duration
+}
+
+// SetListener sets the listener for markers
+func (t *Timeline) SetListener(listener api.ITimelineListener) {
+	t.listener = listener
+}
+
+// pass reports the markers after "from" up to and including "to".
+func (t *Timeline) pass(from, to float64, inclusive bool) {
+	if t.listener == nil {
+		return
+	}
+
+	for _, m := range t.markers {
+		if (m.time > from || inclusive && m.time == from) && m.time <= to {
+			t.listener.MarkerReached(t, m.name)
+		}
+	}
+}
+
+func (t *Timeline) apply() {
+	for _, track := range t.tracks {
+		track.apply(t.time)
+	}
+}
+
+// --------------------------------------------------------
+// Evaluation
+// --------------------------------------------------------
+
+func (k *Track) bound() bool {
+	return k.node != nil && k.prop != nil
+}
+
+// validate unbinds the track if the keyframes don't have as many
+// values as the property.
+func (k *Track) validate() {
+	if !k.bound() {
+		return
+	}
+
+	size := len(k.prop.get(k.node))
+	for _, key := range k.keys {
+		if key.values != nil && len(key.values) != size {
+			fmt.Printf("Timeline: '%s' needs %d values at %0.0fms\n", k.property, size, key.time)
+			k.prop = nil
+			return
+		}
+	}
+}
+
+// resolve captures the property's value for keyframes without values
+func (k *Track) resolve() {
+	if !k.bound() {
+		return
+	}
+
+	var current []float64
+	for _, key := range k.keys {
+		if key.values != nil {
+			key.resolved = key.values
+			continue
+		}
+
+		if current == nil {
+			current = k.prop.get(k.node)
+		}
+		key.resolved = current
+	}
+}
+
+func (k *Track) apply(time float64) {
+	if !k.bound() || len(k.keys) == 0 {
+		return
+	}
+
+	values := k.evaluate(time)
+
+	if k.prop.color {
+		k.applyColor(values)
+		return
+	}
+
+	k.prop.set(k.node, values)
+}
+
+// applyColor sets the components of the node's palette. Nodes often
+// share palettes so the track first gives the node its own, again only
+// if the node's palette is replaced.
+func (k *Track) applyColor(values []float64) {
+	c, ok := k.node.(api.IColorable)
+	if !ok {
+		return
+	}
+
+	if k.palette == nil || c.Color() != k.palette {
+		k.palette = rendering.NewPaletteInt64(0)
+		c.SetColor(k.palette)
+	}
+
+	k.palette.SetRed(component(values[0]))
+	k.palette.SetGreen(component(values[1]))
+	k.palette.SetBlue(component(values[2]))
+	k.palette.SetAlpha(component(values[3]))
+}
+
+// evaluate interpolates the keyframes either side of time
+func (k *Track) evaluate(time float64) []float64 {
+	first := k.keys[0]
+	if time <= first.time {
+		return first.resolved
+	}
+
+	for i := 1; i < len(k.keys); i++ {
+		key := k.keys[i]
+		if time >= key.time {
+			continue
+		}
+
+		previous := k.keys[i-1]
+		if key.step {
+			return previous.resolved
+		}
+
+		p := (time - previous.time) / (key.time - previous.time)
+		if key.ease != nil {
+			p = key.ease.Compute(p)
+		}
+
+		if len(k.values) != len(key.resolved) {
+			k.values = make([]float64, len(key.resolved))
+		}
+		values := k.values
+		for j := range values {
+			from := key.resolved[j]
+			if j < len(previous.resolved) {
+				from = previous.resolved[j]
+			}
+			values[j] = maths.Lerp(from, key.resolved[j], p)
+		}
+		return values
+	}
+
+	return k.keys[len(k.keys)-1].resolved
+}
@@ -0,0 +1,54 @@
+package tweening
+
+import "github.com/wdevore/RangerGo/api"
+
+// BackEquation construct
+type BackEquation struct {
+	compute api.Compute
+}
+
+// NewBackEquation constructs a overshooting equation
+func NewBackEquation(easeType int) api.ITweenEquation {
+	o := new(BackEquation)
+
+	switch easeType {
+	case api.EaseIn:
+		o.compute = backEaseIn
+	case api.EaseOut:
+		o.compute = backEaseOut
+	case api.EaseInOut:
+		o.compute = backEaseInOut
+	}
+
+	return o
+}
+
+// Compute performs tweening
+func (lt *BackEquation) Compute(t float64) float64 {
+	return lt.compute(t)
+}
+
+// backOvershoot is Penner's default overshoot of 10%
+const backOvershoot = 1.70158
+
+func backEaseIn(t float64) float64 {
+	s := backOvershoot
+	return t * t * ((s+1.0)*t - s)
+}
+
+func backEaseOut(t float64) float64 {
+	s := backOvershoot
+	t = t - 1.0
+	return t*t*((s+1.0)*t+s) + 1.0
+}
+
+func backEaseInOut(t float64) float64 {
+	s := backOvershoot * 1.525
+	t = t * 2.0
+	if t < 1.0 {
+		return 0.5 * (t * t * ((s+1.0)*t - s))
+	}
+
+	t = t - 2.0
+	return 0.5 * (t*t*((s+1.0)*t+s) + 2.0)
+}
@@ -0,0 +1,84 @@
+package tweening
+
+import (
+	"math"
+
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine/maths"
+)
+
+// BezierEquation is a custom curve defined by a cubic bezier from
+// (0,0) to (1,1), the same as CSS's cubic-bezier timing function.
+type BezierEquation struct {
+	x1, y1 float64
+	x2, y2 float64
+}
+
+// NewBezierEquation constructs a curve using two control points. The
+// x values are clamped to 0.0 -> 1.0 so the curve is a function of
+// time, the y values may overshoot.
+func NewBezierEquation(x1, y1, x2, y2 float64) api.ITweenEquation {
+	o := new(BezierEquation)
+	o.x1 = maths.Clamp(x1, 0.0, 1.0)
+	o.y1 = y1
+	o.x2 = maths.Clamp(x2, 0.0, 1.0)
+	o.y2 = y2
+	return o
+}
+
+// Compute finds the curve's y at time t
+func (b *BezierEquation) Compute(t float64) float64 {
+	if t <= 0.0 || t >= 1.0 {
+		return t
+	}
+
+	return bezier(b.solve(t), b.y1, b.y2)
+}
+
+// solve finds the curve parameter whose x is t
+func (b *BezierEquation) solve(t float64) float64 {
+	// Newton's method converges quickly for most curves.
+	s := t
+	for i := 0; i < 8; i++ {
+		x := bezier(s, b.x1, b.x2) - t
+		if math.Abs(x) < 1e-7 {
+			return s
+		}
+
+		d := bezierSlope(s, b.x1, b.x2)
+		if math.Abs(d) < 1e-6 {
+			break
+		}
+		s -= x / d
+	}
+
+	// Otherwise fallback to bisection as x is monotonic.
+	lo, hi := 0.0, 1.0
+	s = t
+	for i := 0; i < 50; i++ {
+		x := bezier(s, b.x1, b.x2)
+		if math.Abs(x-t) < 1e-7 {
+			break
+		}
+
+		if x < t {
+			lo = s
+		} else {
+			hi = s
+		}
+		s = (lo + hi) / 2.0
+	}
+
+	return s
+}
+
+// bezier evaluates one axis of the curve with end points 0 and 1
+func bezier(s, p1, p2 float64) float64 {
+	r := 1.0 - s
+	return 3.0*r*r*s*p1 + 3.0*r*s*s*p2 + s*s*s
+}
+
+func bezierSlope(s, p1, p2 float64) float64 {
+	r := 1.0 - s
+	return 3.0*r*r*p1 + 6.0*r*s*(p2-p1) + 3.0*s*s*(1.0-p2)
+}
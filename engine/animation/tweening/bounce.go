@@ -0,0 +1,57 @@
+package tweening
+
+import "github.com/wdevore/RangerGo/api"
+
+// BounceEquation construct
+type BounceEquation struct {
+	compute api.Compute
+}
+
+// NewBounceEquation constructs a bouncing equation
+func NewBounceEquation(easeType int) api.ITweenEquation {
+	o := new(BounceEquation)
+
+	switch easeType {
+	case api.EaseIn:
+		o.compute = bounceEaseIn
+	case api.EaseOut:
+		o.compute = bounceEaseOut
+	case api.EaseInOut:
+		o.compute = bounceEaseInOut
+	}
+
+	return o
+}
+
+// Compute performs tweening
+func (lt *BounceEquation) Compute(t float64) float64 {
+	return lt.compute(t)
+}
+
+func bounceEaseIn(t float64) float64 {
+	return 1.0 - bounceEaseOut(1.0-t)
+}
+
+func bounceEaseOut(t float64) float64 {
+	switch {
+	case t < 1.0/2.75:
+		return 7.5625 * t * t
+	case t < 2.0/2.75:
+		t = t - 1.5/2.75
+		return 7.5625*t*t + 0.75
+	case t < 2.5/2.75:
+		t = t - 2.25/2.75
+		return 7.5625*t*t + 0.9375
+	}
+
+	t = t - 2.625/2.75
+	return 7.5625*t*t + 0.984375
+}
+
+func bounceEaseInOut(t float64) float64 {
+	if t < 0.5 {
+		return bounceEaseIn(t*2.0) * 0.5
+	}
+
+	return bounceEaseOut(t*2.0-1.0)*0.5 + 0.5
+}
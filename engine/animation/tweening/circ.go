@@ -0,0 +1,52 @@
+package tweening
+
+import (
+	"math"
+
+	"github.com/wdevore/RangerGo/api"
+)
+
+// CircEquation construct
+type CircEquation struct {
+	compute api.Compute
+}
+
+// NewCircEquation constructs a circular equation
+func NewCircEquation(easeType int) api.ITweenEquation {
+	o := new(CircEquation)
+
+	switch easeType {
+	case api.EaseIn:
+		o.compute = circEaseIn
+	case api.EaseOut:
+		o.compute = circEaseOut
+	case api.EaseInOut:
+		o.compute = circEaseInOut
+	}
+
+	return o
+}
+
+// Compute performs tweening
+func (lt *CircEquation) Compute(t float64) float64 {
+	return lt.compute(t)
+}
+
+func circEaseIn(t float64) float64 {
+	return 1.0 - math.Sqrt(1.0-t*t)
+}
+
+func circEaseOut(t float64) float64 {
+	t = t - 1.0
+	return math.Sqrt(1.0 - t*t)
+}
+
+func circEaseInOut(t float64) float64 {
+	t = t * 2.0
+	if t < 1.0 {
+		return -0.5 * (math.Sqrt(1.0-t*t) - 1.0)
+	}
+
+	t = t - 2.0
+	return 0.5 * (math.Sqrt(1.0-t*t) + 1.0)
+}
@@ -0,0 +1,48 @@
+package tweening
+
+import "github.com/wdevore/RangerGo/api"
+
+// CubicEquation construct
+type CubicEquation struct {
+	compute api.Compute
+}
+
+// NewCubicEquation constructs a cubic equation
+func NewCubicEquation(easeType int) api.ITweenEquation {
+	o := new(CubicEquation)
+
+	switch easeType {
+	case api.EaseIn:
+		o.compute = cubicEaseIn
+	case api.EaseOut:
+		o.compute = cubicEaseOut
+	case api.EaseInOut:
+		o.compute = cubicEaseInOut
+	}
+
+	return o
+}
+
+// Compute performs tweening
+func (lt *CubicEquation) Compute(t float64) float64 {
+	return lt.compute(t)
+}
+
+func cubicEaseIn(t float64) float64 {
+	return t * t * t
+}
+
+func cubicEaseOut(t float64) float64 {
+	t = t - 1.0
+	return t*t*t + 1.0
+}
+
+func cubicEaseInOut(t float64) float64 {
+	t = t * 2.0
+	if t < 1.0 {
+		return 0.5 * t * t * t
+	}
+
+	t = t - 2.0
+	return 0.5 * (t*t*t + 2.0)
+}
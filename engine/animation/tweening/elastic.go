@@ -0,0 +1,72 @@
+package tweening
+
+import (
+	"math"
+
+	"github.com/wdevore/RangerGo/api"
+)
+
+// ElasticEquation construct
+type ElasticEquation struct {
+	compute api.Compute
+}
+
+// NewElasticEquation constructs a spring like equation
+func NewElasticEquation(easeType int) api.ITweenEquation {
+	o := new(ElasticEquation)
+
+	switch easeType {
+	case api.EaseIn:
+		o.compute = elasticEaseIn
+	case api.EaseOut:
+		o.compute = elasticEaseOut
+	case api.EaseInOut:
+		o.compute = elasticEaseInOut
+	}
+
+	return o
+}
+
+// Compute performs tweening
+func (lt *ElasticEquation) Compute(t float64) float64 {
+	return lt.compute(t)
+}
+
+// elasticPeriod is the period of the oscillation
+const elasticPeriod = 0.3
+
+func elasticEaseIn(t float64) float64 {
+	if t == 0.0 || t == 1.0 {
+		return t
+	}
+
+	p := elasticPeriod
+	s := p / 4.0
+	t = t - 1.0
+	return -(math.Pow(2.0, 10.0*t) * math.Sin((t-s)*(2.0*math.Pi)/p))
+}
+
+func elasticEaseOut(t float64) float64 {
+	if t == 0.0 || t == 1.0 {
+		return t
+	}
+
+	p := elasticPeriod
+	s := p / 4.0
+	return math.Pow(2.0, -10.0*t)*math.Sin((t-s)*(2.0*math.Pi)/p) + 1.0
+}
+
+func elasticEaseInOut(t float64) float64 {
+	if t == 0.0 || t == 1.0 {
+		return t
+	}
+
+	p := elasticPeriod * 1.5
+	s := p / 4.0
+	t = t*2.0 - 1.0
+	if t < 0.0 {
+		return -0.5 * (math.Pow(2.0, 10.0*t) * math.Sin((t-s)*(2.0*math.Pi)/p))
+	}
+
+	return math.Pow(2.0, -10.0*t)*math.Sin((t-s)*(2.0*math.Pi)/p)*0.5 + 1.0
+}
@@ -45,5 +45,6 @@ func quadEaseInOut(t float64) float64 {
 		return 0.5 * t * t
 	}
 
-	return -0.5 * ((t-1.0)*(t-2.0) - 1.0)
+	t = t - 1.0
+	return -0.5 * (t*(t-2.0) - 1.0)
 }
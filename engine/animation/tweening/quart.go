@@ -0,0 +1,48 @@
+package tweening
+
+import "github.com/wdevore/RangerGo/api"
+
+// QuartEquation construct
+type QuartEquation struct {
+	compute api.Compute
+}
+
+// NewQuartEquation constructs a quartic equation
+func NewQuartEquation(easeType int) api.ITweenEquation {
+	o := new(QuartEquation)
+
+	switch easeType {
+	case api.EaseIn:
+		o.compute = quartEaseIn
+	case api.EaseOut:
+		o.compute = quartEaseOut
+	case api.EaseInOut:
+		o.compute = quartEaseInOut
+	}
+
+	return o
+}
+
+// Compute performs tweening
+func (lt *QuartEquation) Compute(t float64) float64 {
+	return lt.compute(t)
+}
+
+func quartEaseIn(t float64) float64 {
+	return t * t * t * t
+}
+
+func quartEaseOut(t float64) float64 {
+	t = t - 1.0
+	return 1.0 - t*t*t*t
+}
+
+func quartEaseInOut(t float64) float64 {
+	t = t * 2.0
+	if t < 1.0 {
+		return 0.5 * t * t * t * t
+	}
+
+	t = t - 2.0
+	return -0.5 * (t*t*t*t - 2.0)
+}
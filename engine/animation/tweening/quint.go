@@ -0,0 +1,48 @@
+package tweening
+
+import "github.com/wdevore/RangerGo/api"
+
+// QuintEquation construct
+type QuintEquation struct {
+	compute api.Compute
+}
+
+// NewQuintEquation constructs a quintic equation
+func NewQuintEquation(easeType int) api.ITweenEquation {
+	o := new(QuintEquation)
+
+	switch easeType {
+	case api.EaseIn:
+		o.compute = quintEaseIn
+	case api.EaseOut:
+		o.compute = quintEaseOut
+	case api.EaseInOut:
+		o.compute = quintEaseInOut
+	}
+
+	return o
+}
+
+// Compute performs tweening
+func (lt *QuintEquation) Compute(t float64) float64 {
+	return lt.compute(t)
+}
+
+func quintEaseIn(t float64) float64 {
+	return t * t * t * t * t
+}
+
+func quintEaseOut(t float64) float64 {
+	t = t - 1.0
+	return t*t*t*t*t + 1.0
+}
+
+func quintEaseInOut(t float64) float64 {
+	t = t * 2.0
+	if t < 1.0 {
+		return 0.5 * t * t * t * t * t
+	}
+
+	t = t - 2.0
+	return 0.5 * (t*t*t*t*t + 2.0)
+}
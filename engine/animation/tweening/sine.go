@@ -0,0 +1,45 @@
+package tweening
+
+import (
+	"math"
+
+	"github.com/wdevore/RangerGo/api"
+)
+
+// SineEquation construct
+type SineEquation struct {
+	compute api.Compute
+}
+
+// NewSineEquation constructs a sinusoidal equation
+func NewSineEquation(easeType int) api.ITweenEquation {
+	o := new(SineEquation)
+
+	switch easeType {
+	case api.EaseIn:
+		o.compute = sineEaseIn
+	case api.EaseOut:
+		o.compute = sineEaseOut
+	case api.EaseInOut:
+		o.compute = sineEaseInOut
+	}
+
+	return o
+}
+
+// Compute performs tweening
+func (lt *SineEquation) Compute(t float64) float64 {
+	return lt.compute(t)
+}
+
+func sineEaseIn(t float64) float64 {
+	return 1.0 - math.Cos(t*math.Pi/2.0)
+}
+
+func sineEaseOut(t float64) float64 {
+	return math.Sin(t * math.Pi / 2.0)
+}
+
+func sineEaseInOut(t float64) float64 {
+	return -0.5 * (math.Cos(math.Pi*t) - 1.0)
+}
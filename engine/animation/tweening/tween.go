@@ -1,18 +1,11 @@
 package tweening
 
 import (
+	"math"
+
 	"github.com/wdevore/RangerGo/api"
-	"github.com/wdevore/RangerGo/engine/maths"
 )
 
-// ##################################################################
-// Note: This was/is an experiment to see if I could implement
-// basic easing animation sand understand them--mission sort of accomplished.
-//
-// Nonetheless, I recommend you use : github.com/tanema/gween instead as
-// is it more feature complete with more easings.
-// ##################################################################
-
 // Tween performs tween animations on values
 type Tween struct {
 	equation api.ITweenEquation
@@ -22,59 +15,118 @@ type Tween struct {
 	begin    float64
 	end      float64
 
+	delay  float64
+	repeat int
+	yoyo   bool
+
 	// Controls
-	elapsed   float64
-	change    float64
-	accumTime float64
+	elapsed float64
+	change  float64
 }
 
-// NewTween constructs a new tweener
+// NewTween constructs a new tweener using one of the Equation constants
+// and an ease style, for example, api.EquationBounce and api.EaseOut.
 func NewTween(begin, end, duration float64, equation, style int) api.ITween {
+	return NewTweenUsing(begin, end, duration, NewEquation(equation, style))
+}
+
+// NewTweenUsing constructs a new tweener using an equation, for
+// example, a BezierEquation.
+func NewTweenUsing(begin, end, duration float64, equation api.ITweenEquation) api.ITween {
 	o := new(Tween)
 	o.duration = duration
 	o.begin = begin
 	o.end = end
 	o.change = end - begin
+	o.equation = equation
+	return o
+}
 
+// NewEquation constructs one of the Equation constants
+func NewEquation(equation, style int) api.ITweenEquation {
 	switch equation {
-	case api.EquationLinear:
-		o.equation = NewLinearEquation(style)
 	case api.EquationExpo:
-		o.equation = NewExpoEquation(style)
+		return NewExpoEquation(style)
 	case api.EquationQuad:
-		o.equation = NewQuadEquation(style)
+		return NewQuadEquation(style)
+	case api.EquationSine:
+		return NewSineEquation(style)
+	case api.EquationCubic:
+		return NewCubicEquation(style)
+	case api.EquationQuart:
+		return NewQuartEquation(style)
+	case api.EquationQuint:
+		return NewQuintEquation(style)
+	case api.EquationCirc:
+		return NewCircEquation(style)
+	case api.EquationBack:
+		return NewBackEquation(style)
+	case api.EquationElastic:
+		return NewElasticEquation(style)
+	case api.EquationBounce:
+		return NewBounceEquation(style)
 	}
 
-	return o
+	return NewLinearEquation(style)
+}
+
+// SetDelay sets how long to wait before starting, in milliseconds
+func (t *Tween) SetDelay(delay float64) {
+	t.delay = delay
+}
+
+// SetRepeat sets how many times the tween plays again
+func (t *Tween) SetRepeat(count int) {
+	t.repeat = count
+}
+
+// SetYoyo plays every other repeat backwards
+func (t *Tween) SetYoyo(yoyo bool) {
+	t.yoyo = yoyo
 }
 
 // Update interpolates values at each dt (ms) = ms-per-frame ~= 33.333ms
 func (t *Tween) Update(dt float64) (value float64, isFinished bool) {
-	step := dt / t.duration
-
 	t.elapsed += dt
 
-	t.accumTime += step
+	active := t.elapsed - t.delay
+	if active < 0.0 {
+		return t.begin, false
+	}
 
-	interpolation := maths.Clamp(t.equation.Compute(t.accumTime), 0.0, 1.0)
+	// Which play through, and how far into it.
+	cycle := 0
+	progress := 1.0
+	if t.duration > 0.0 {
+		cycle = int(math.Floor(active / t.duration))
+		progress = (active - float64(cycle)*t.duration) / t.duration
+	}
 
-	// fmt.Println(t.accumTime, " ", step, " ", t.elapsed, " ", interpolation)
+	if t.duration <= 0.0 {
+		isFinished = true
+	} else if t.repeat != api.RepeatForever && cycle > t.repeat {
+		// Finish at the end of the last play through.
+		isFinished = true
+		cycle = t.repeat
+		progress = 1.0
+	}
 
-	isFinished = t.elapsed >= t.duration
+	if t.yoyo && cycle%2 == 1 {
+		progress = 1.0 - progress
+	}
 
-	value = interpolation*t.change + t.begin
+	value = t.equation.Compute(progress)*t.change + t.begin
 
 	return value, isFinished
 }
 
-// Elapsed returns how much time has passed since animation began.
-// It should eventually equal duration.
+// Elapsed returns how much time has passed since animation began,
+// including the delay and repeats.
 func (t *Tween) Elapsed() float64 {
 	return t.elapsed
 }
 
 // Reset tween
 func (t *Tween) Reset() {
-	t.accumTime = 0.0
 	t.elapsed = 0.0
 }
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/veandco/go-sdl2/sdl"
+	"github.com/veandco/go-sdl2/ttf"
 
 	"github.com/wdevore/RangerGo/api"
 	"github.com/wdevore/RangerGo/engine/nodes"
@@ -141,7 +142,7 @@ func (e *engine) Configure() {
 	// e.bounds = image.Rect(0, 0, int(e.world.WindowSize().X()), int(e.world.WindowSize().Y()))
 	// e.pixels = image.NewRGBA(e.bounds)
 
-	renderer.SetDrawBlendMode(sdl.BLENDMODE_BLEND)
+	e.world.Context().SetBlendMode(api.BlendAlpha)
 
 	fmt.Println("Configure complete.")
 }
@@ -273,6 +274,10 @@ func (e *engine) End() {
 	e.world.Renderer().Destroy()
 	fmt.Println("Disposing window...")
 	e.window.Destroy()
+	if ttf.WasInit() {
+		fmt.Println("Quitting SDL_ttf...")
+		ttf.Quit()
+	}
 	fmt.Println("Quitting SDL...")
 	sdl.Quit()
 
@@ -354,10 +359,16 @@ func (e *engine) filterEvent(ev sdl.Event, userdata interface{}) bool {
 		event.SetRepeat(t.Repeat)
 		event.SetKeyScan(uint32(t.Keysym.Scancode))
 		event.SetKeyCode(uint32(t.Keysym.Sym))
+		event.SetKeyMotif(uint32(t.Keysym.Mod))
 		e.sceneGraph.RouteEvents(event)
 		// fmt.Printf("[%d ms] Keyboard\ttype:%d\tsym:%c\tmodifiers:%d\tstate:%d\trepeat:%d\n",
 		// 	t.Timestamp, t.Type, t.Keysym.Sym, t.Keysym.Mod, t.State, t.Repeat)
 		return false
+	case *sdl.TextInputEvent:
+		event.SetType(api.IOTypeTextInput)
+		event.SetText(t.GetText())
+		e.sceneGraph.RouteEvents(event)
+		return false
 	}
 
 	// True means we didn't handled it. Allow it to be queued.
@@ -41,6 +41,12 @@ func (m *Mesh) SetVertex(x, y float64, index int) {
 	m.vertices[index].SetByComp(x, y)
 }
 
+// Clear removes all vertices so the mesh can be rebuilt
+func (m *Mesh) Clear() {
+	m.vertices = m.vertices[:0]
+	m.bucket = m.bucket[:0]
+}
+
 // Build sizes the transform bucket
 func (m *Mesh) Build() {
 	// bucket needs to be the same size as vertices
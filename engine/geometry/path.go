@@ -0,0 +1,266 @@
+package geometry
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine/maths"
+)
+
+// Number of samples per segment in the arc-length table
+const pathSamples = 16
+
+type curve func(p0, p1, p2, p3, t float64) float64
+
+// Path is an api.IPath made of cubic segments
+type Path struct {
+	points []api.IPoint
+	closed bool
+
+	eval, tangent curve
+	// controls builds the segments' control points from the points
+	controls func(points []api.IPoint, closed bool) (ctrl []api.IPoint, stride int)
+
+	ctrl   []api.IPoint
+	stride int
+
+	// Arc-length table: distances[i] is the length up to sample i
+	distances []float64
+	length    float64
+}
+
+// NewCatmullRomPath constructs a path passing through its points
+func NewCatmullRomPath() api.IPath {
+	o := new(Path)
+	o.eval = maths.CatmullRom
+	o.tangent = maths.CatmullRomTangent
+	o.controls = func(points []api.IPoint, closed bool) ([]api.IPoint, int) {
+		n := len(points)
+		if closed {
+			return wrapped(points, 1, 2), 1
+		}
+		return padded(points, points[0], points[n-1], 1), 1
+	}
+	return o
+}
+
+// NewLinearPath constructs a path of straight lines between its points,
+// for example, a path found by navigation.
+func NewLinearPath() api.IPath {
+	o := new(Path)
+	o.eval = func(p0, p1, p2, p3, t float64) float64 { return maths.Lerp(p1, p2, t) }
+	o.tangent = func(p0, p1, p2, p3, t float64) float64 { return p2 - p1 }
+	o.controls = func(points []api.IPoint, closed bool) ([]api.IPoint, int) {
+		n := len(points)
+		if closed {
+			return wrapped(points, 1, 2), 1
+		}
+		return padded(points, points[0], points[n-1], 1), 1
+	}
+	return o
+}
+
+// NewBezierPath constructs a path of cubic Bezier segments. The points
+// are anchor, control, control, anchor, control, control, anchor...
+// A closed path ends with two controls that lead back to the first
+// anchor.
+func NewBezierPath() api.IPath {
+	o := new(Path)
+	o.eval = maths.CubicBezier
+	o.tangent = maths.CubicBezierTangent
+	o.controls = func(points []api.IPoint, closed bool) ([]api.IPoint, int) {
+		ctrl := append([]api.IPoint{}, points...)
+		if closed {
+			ctrl = append(ctrl, points[0])
+		}
+		return ctrl, 3
+	}
+	return o
+}
+
+// NewBSplinePath constructs a smooth path near its points. Open paths
+// start and end at their first and last points.
+func NewBSplinePath() api.IPath {
+	o := new(Path)
+	o.eval = maths.BSpline
+	o.tangent = maths.BSplineTangent
+	o.controls = func(points []api.IPoint, closed bool) ([]api.IPoint, int) {
+		n := len(points)
+		if closed {
+			return wrapped(points, 1, 2), 1
+		}
+		return padded(points, points[0], points[n-1], 2), 1
+	}
+	return o
+}
+
+// padded repeats the first and last points count times at each end
+func padded(points []api.IPoint, first, last api.IPoint, count int) []api.IPoint {
+	ctrl := []api.IPoint{}
+	for i := 0; i < count; i++ {
+		ctrl = append(ctrl, first)
+	}
+	ctrl = append(ctrl, points...)
+	for i := 0; i < count; i++ {
+		ctrl = append(ctrl, last)
+	}
+	return ctrl
+}
+
+// wrapped surrounds the points with those from the other end
+func wrapped(points []api.IPoint, before, after int) []api.IPoint {
+	n := len(points)
+	ctrl := append([]api.IPoint{}, points[n-before:]...)
+	ctrl = append(ctrl, points...)
+	return append(ctrl, points[:after]...)
+}
+
+// AddPoint appends a point
+func (p *Path) AddPoint(x, y float64) {
+	p.points = append(p.points, NewPointUsing(x, y))
+}
+
+// SetPoint updates a point. Call Build afterwards.
+func (p *Path) SetPoint(x, y float64, index int) {
+	p.points[index].SetByComp(x, y)
+}
+
+// Points returns the path's points
+func (p *Path) Points() []api.IPoint {
+	return p.points
+}
+
+// SetClosed joins the ends
+func (p *Path) SetClosed(closed bool) {
+	p.closed = closed
+}
+
+// IsClosed indicates if the ends are joined
+func (p *Path) IsClosed() bool {
+	return p.closed
+}
+
+// Build measures the path. Paths need at least two points, and Bezier
+// paths need whole segments.
+func (p *Path) Build() {
+	p.ctrl = nil
+	p.distances = nil
+	p.length = 0.0
+
+	if len(p.points) < 2 {
+		fmt.Println("Path: at least two points are needed")
+		return
+	}
+
+	p.ctrl, p.stride = p.controls(p.points, p.closed)
+
+	if len(p.ctrl) < 4 || (len(p.ctrl)-4)%p.stride != 0 {
+		fmt.Println("Path: Bezier paths need 3 points per segment plus one")
+		p.ctrl = nil
+		return
+	}
+
+	segments := (len(p.ctrl)-4)/p.stride + 1
+
+	p.distances = make([]float64, segments*pathSamples+1)
+
+	px, py := p.evaluate(0, 0.0)
+	for i := 1; i < len(p.distances); i++ {
+		x, y := p.evaluate((i-1)/pathSamples, float64((i-1)%pathSamples+1)/pathSamples)
+		p.length += math.Hypot(x-px, y-py)
+		p.distances[i] = p.length
+		px, py = x, y
+	}
+}
+
+// Length returns the arc-length
+func (p *Path) Length() float64 {
+	return p.length
+}
+
+// PointAt sets out to the point at a distance
+func (p *Path) PointAt(distance float64, out api.IPoint) {
+	if p.ctrl == nil {
+		return
+	}
+
+	out.SetByComp(p.evaluate(p.locate(distance)))
+}
+
+// TangentAt sets out to the path's direction at a distance
+func (p *Path) TangentAt(distance float64, out api.IVector) {
+	if p.ctrl == nil {
+		return
+	}
+
+	segment, t := p.locate(distance)
+	c := p.segment(segment)
+	out.SetByComp(
+		p.tangent(c[0].X(), c[1].X(), c[2].X(), c[3].X(), t),
+		p.tangent(c[0].Y(), c[1].Y(), c[2].Y(), c[3].Y(), t))
+
+	if out.LengthSqr() < maths.Epsilon {
+		// Repeated points stall the curve. Use the chord around the
+		// distance instead.
+		h := math.Min(1.0, p.length/100.0)
+		x1, y1 := p.evaluate(p.locate(distance - h))
+		x2, y2 := p.evaluate(p.locate(distance + h))
+		out.SetByComp(x2-x1, y2-y1)
+	}
+
+	out.Normalize()
+}
+
+// locate converts a distance into a segment and its t
+func (p *Path) locate(distance float64) (segment int, t float64) {
+	if p.closed && p.length > 0.0 {
+		distance = math.Mod(distance, p.length)
+		if distance < 0.0 {
+			distance += p.length
+		}
+	} else {
+		distance = maths.Clamp(distance, 0.0, p.length)
+	}
+
+	// First sample at or beyond the distance
+	i := sort.SearchFloat64s(p.distances, distance)
+	if i == 0 {
+		return 0, 0.0
+	}
+	if i >= len(p.distances) {
+		i = len(p.distances) - 1
+	}
+
+	// Interpolate between the samples
+	d0, d1 := p.distances[i-1], p.distances[i]
+	f := 0.0
+	if d1 > d0 {
+		f = (distance - d0) / (d1 - d0)
+	}
+
+	sample := float64(i-1) + f
+	segment = int(sample) / pathSamples
+	segments := (len(p.distances) - 1) / pathSamples
+	if segment >= segments {
+		return segments - 1, 1.0
+	}
+
+	return segment, (sample - float64(segment*pathSamples)) / pathSamples
+}
+
+func (p *Path) segment(segment int) []api.IPoint {
+	i := segment * p.stride
+	return p.ctrl[i : i+4]
+}
+
+func (p *Path) evaluate(segment int, t float64) (x, y float64) {
+	c := p.segment(segment)
+	return p.eval(c[0].X(), c[1].X(), c[2].X(), c[3].X(), t),
+		p.eval(c[0].Y(), c[1].Y(), c[2].Y(), c[3].Y(), t)
+}
+
+func (p Path) String() string {
+	return fmt.Sprintf("Path: %d points, length: %0.2f", len(p.points), p.length)
+}
@@ -88,6 +88,8 @@ func setBounds(r *rectangle, vertices []api.IPoint, minx, miny, maxx, maxy float
 
 	r.min.SetByComp(minx, miny)
 	r.max.SetByComp(maxx, maxy)
+	r.width = maxx - minx
+	r.height = maxy - miny
 }
 
 // Intersect computes the intersection of rect A and B
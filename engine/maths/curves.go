@@ -0,0 +1,57 @@
+package maths
+
+// Cubic curve segments on one axis. Each takes four control values and
+// t = 0->1 across the segment. The Tangent versions return the
+// derivative with respect to t.
+
+// CatmullRom returns a point on a uniform Catmull-Rom segment, which
+// passes through p1 (t = 0) and p2 (t = 1).
+func CatmullRom(p0, p1, p2, p3, t float64) float64 {
+	t2 := t * t
+	t3 := t2 * t
+	return 0.5 * (2.0*p1 +
+		(-p0+p2)*t +
+		(2.0*p0-5.0*p1+4.0*p2-p3)*t2 +
+		(-p0+3.0*p1-3.0*p2+p3)*t3)
+}
+
+// CatmullRomTangent returns the derivative of CatmullRom
+func CatmullRomTangent(p0, p1, p2, p3, t float64) float64 {
+	t2 := t * t
+	return 0.5 * ((-p0 + p2) +
+		2.0*(2.0*p0-5.0*p1+4.0*p2-p3)*t +
+		3.0*(-p0+3.0*p1-3.0*p2+p3)*t2)
+}
+
+// CubicBezier returns a point on a cubic Bezier segment from p0 to p3
+// with control values p1 and p2.
+func CubicBezier(p0, p1, p2, p3, t float64) float64 {
+	u := 1.0 - t
+	return u*u*u*p0 + 3.0*u*u*t*p1 + 3.0*u*t*t*p2 + t*t*t*p3
+}
+
+// CubicBezierTangent returns the derivative of CubicBezier
+func CubicBezierTangent(p0, p1, p2, p3, t float64) float64 {
+	u := 1.0 - t
+	return 3.0*u*u*(p1-p0) + 6.0*u*t*(p2-p1) + 3.0*t*t*(p3-p2)
+}
+
+// BSpline returns a point on a uniform cubic B-spline segment. The
+// curve is smooth but doesn't pass through the control values.
+func BSpline(p0, p1, p2, p3, t float64) float64 {
+	t2 := t * t
+	t3 := t2 * t
+	return ((-t3+3.0*t2-3.0*t+1.0)*p0 +
+		(3.0*t3-6.0*t2+4.0)*p1 +
+		(-3.0*t3+3.0*t2+3.0*t+1.0)*p2 +
+		t3*p3) / 6.0
+}
+
+// BSplineTangent returns the derivative of BSpline
+func BSplineTangent(p0, p1, p2, p3, t float64) float64 {
+	t2 := t * t
+	return ((-3.0*t2+6.0*t-3.0)*p0 +
+		(9.0*t2-12.0*t)*p1 +
+		(-9.0*t2+6.0*t+3.0)*p2 +
+		3.0*t2*p3) / 6.0
+}
@@ -0,0 +1,200 @@
+package misc
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine/geometry"
+	"github.com/wdevore/RangerGo/engine/maths"
+)
+
+type camera struct {
+	// position is where the camera is as of the last update.
+	position api.IPoint
+	previous api.IPoint
+	tracked  api.IPoint
+
+	zoom     float64
+	rotation float64
+
+	viewWidth, viewHeight float64
+
+	smoothing             float64
+	deadWidth, deadHeight float64
+
+	bounded bool
+	bounds  api.IRectangle
+
+	shakeMagnitude float64
+	shakeDuration  float64
+	shakeTime      float64
+	shake          api.IPoint
+
+	// rendered is the interpolated position plus shake
+	rendered api.IPoint
+
+	placement api.IAffineTransform
+	transform api.IAffineTransform
+}
+
+// NewCamera constructs an ICamera object
+func NewCamera() api.ICamera {
+	o := new(camera)
+	o.position = geometry.NewPoint()
+	o.previous = geometry.NewPoint()
+	o.tracked = geometry.NewPoint()
+	o.shake = geometry.NewPoint()
+	o.rendered = geometry.NewPoint()
+	o.bounds = geometry.NewRectangle()
+	o.placement = maths.NewTransform()
+	o.transform = maths.NewTransform()
+	o.zoom = 1.0
+	o.smoothing = 1.0
+	return o
+}
+
+func (c *camera) SetPosition(x, y float64) {
+	c.position.SetByComp(x, y)
+	c.clamp()
+	c.previous.SetByPoint(c.position)
+	c.tracked.SetByPoint(c.position)
+	c.rendered.SetByPoint(c.position)
+}
+
+func (c *camera) Position() api.IPoint {
+	return c.position
+}
+
+func (c *camera) SetZoom(zoom float64) {
+	c.zoom = zoom
+	c.clamp()
+}
+
+func (c *camera) Zoom() float64 {
+	return c.zoom
+}
+
+func (c *camera) SetRotation(radians float64) {
+	c.rotation = radians
+}
+
+func (c *camera) Rotation() float64 {
+	return c.rotation
+}
+
+func (c *camera) SetViewSize(w, h float64) {
+	c.viewWidth = w
+	c.viewHeight = h
+	c.clamp()
+}
+
+func (c *camera) Track(x, y float64) {
+	c.tracked.SetByComp(x, y)
+}
+
+func (c *camera) SetSmoothing(smoothing float64) {
+	c.smoothing = maths.Clamp(smoothing, 0.0, 1.0)
+}
+
+func (c *camera) SetDeadZone(w, h float64) {
+	c.deadWidth = w
+	c.deadHeight = h
+}
+
+func (c *camera) SetBounds(minX, minY, maxX, maxY float64) {
+	c.bounded = true
+	c.bounds.Set(minX, minY, maxX, maxY)
+	c.clamp()
+}
+
+func (c *camera) ClearBounds() {
+	c.bounded = false
+}
+
+func (c *camera) Shake(magnitude, duration float64) {
+	c.shakeMagnitude = magnitude
+	c.shakeDuration = duration
+	c.shakeTime = duration
+}
+
+func (c *camera) Update(msPerUpdate float64) {
+	c.previous.SetByPoint(c.position)
+
+	x := approach(c.position.X(), c.tracked.X(), c.deadWidth/2.0, c.smoothing)
+	y := approach(c.position.Y(), c.tracked.Y(), c.deadHeight/2.0, c.smoothing)
+	c.position.SetByComp(x, y)
+	c.clamp()
+
+	if c.shakeTime > 0.0 {
+		c.shakeTime -= msPerUpdate
+		strength := c.shakeMagnitude * math.Max(c.shakeTime, 0.0) / c.shakeDuration
+		c.shake.SetByComp((rand.Float64()*2.0-1.0)*strength, (rand.Float64()*2.0-1.0)*strength)
+	} else {
+		c.shake.SetByComp(0.0, 0.0)
+	}
+}
+
+// approach moves "from" towards "to" once "to" is outside of the
+// dead-zone's half extent.
+func approach(from, to, halfDead, smoothing float64) float64 {
+	delta := to - from
+
+	if math.Abs(delta) <= halfDead {
+		return from
+	}
+
+	// Only close the distance to the edge of the dead-zone.
+	desired := to - math.Copysign(halfDead, delta)
+
+	return from + (desired-from)*smoothing
+}
+
+// clamp keeps the visible area inside the bounds. If the visible area is
+// larger than the bounds the camera is centered on them.
+func (c *camera) clamp() {
+	if !c.bounded {
+		return
+	}
+
+	halfW := c.viewWidth / c.zoom / 2.0
+	halfH := c.viewHeight / c.zoom / 2.0
+
+	min := c.bounds.Min()
+	max := c.bounds.Max()
+
+	c.position.SetByComp(
+		clampAxis(c.position.X(), min.X()+halfW, max.X()-halfW),
+		clampAxis(c.position.Y(), min.Y()+halfH, max.Y()-halfH))
+}
+
+func clampAxis(value, min, max float64) float64 {
+	if min > max {
+		return (min + max) / 2.0
+	}
+	return maths.Clamp(value, min, max)
+}
+
+func (c *camera) Interpolate(interpolation float64) {
+	c.rendered.SetByComp(
+		maths.Lerp(c.previous.X(), c.position.X(), interpolation)+c.shake.X(),
+		maths.Lerp(c.previous.Y(), c.position.Y(), interpolation)+c.shake.Y())
+}
+
+func (c *camera) Transform() api.IAffineTransform {
+	// The camera's placement in camera-space is the inverse of the
+	// transform applied to the camera-space.
+	c.placement.MakeTranslate(c.rendered.X(), c.rendered.Y())
+
+	if c.rotation != 0.0 {
+		c.placement.Rotate(c.rotation)
+	}
+
+	if c.zoom != 1.0 {
+		c.placement.Scale(1.0/c.zoom, 1.0/c.zoom)
+	}
+
+	c.placement.InvertTo(c.transform)
+
+	return c.transform
+}
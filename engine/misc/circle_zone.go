@@ -52,11 +52,11 @@ func (z *CircleZone) Update(position api.IPoint) (state int, stateChanged bool)
 func (z *CircleZone) PointInside(point api.IPoint) int {
 	distance := z.DistanceFromCenter(point)
 
-	if distance < z.outerRadius && distance < z.innerRadius {
+	if distance < z.innerRadius {
 		return api.ZoneStateEnteredInner
 	}
 
-	if distance < z.outerRadius && distance > z.innerRadius {
+	if distance < z.outerRadius {
 		return api.ZoneStateEnteredOuter
 	}
 
@@ -0,0 +1,180 @@
+package misc
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/wdevore/RangerGo/api"
+)
+
+// renderCall is a function waiting to run on the render thread
+type renderCall struct {
+	fn   func()
+	done chan bool
+}
+
+type loader struct {
+	world   api.IWorld
+	builder api.SceneBuilder
+
+	listener api.ILoadListener
+
+	mutex    sync.Mutex
+	started  bool
+	stopped  bool
+	progress float64
+	done     bool
+	notified bool
+	scene    api.INode
+	err      error
+
+	calls chan *renderCall
+	// cancel is closed by Stop to release a builder waiting in Call
+	cancel chan bool
+}
+
+// NewLoader constructs an ILoader that builds a scene using a builder
+func NewLoader(world api.IWorld, builder api.SceneBuilder) api.ILoader {
+	o := new(loader)
+	o.world = world
+	o.builder = builder
+	o.calls = make(chan *renderCall)
+	o.cancel = make(chan bool)
+	return o
+}
+
+func (l *loader) Start() {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if l.started {
+		return
+	}
+	l.started = true
+
+	go l.build()
+}
+
+// build runs the builder, recovering a panic rather than letting it
+// take down the process from the worker goroutine.
+func (l *loader) build() {
+	var scene api.INode
+	var err error
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("Loader: builder panicked: %v", r)
+			fmt.Println(err)
+		}
+
+		l.mutex.Lock()
+		l.scene = scene
+		l.err = err
+		if err == nil {
+			l.progress = 1.0
+		}
+		l.done = true
+		l.mutex.Unlock()
+	}()
+
+	scene = l.builder(l.world, l)
+}
+
+func (l *loader) Stop() {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if !l.stopped {
+		l.stopped = true
+		close(l.cancel)
+	}
+}
+
+func (l *loader) IsStopped() bool {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	return l.stopped
+}
+
+func (l *loader) SetProgress(fraction float64) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	l.progress = fraction
+}
+
+func (l *loader) Progress() float64 {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	return l.progress
+}
+
+func (l *loader) Call(fn func()) bool {
+	call := &renderCall{fn: fn, done: make(chan bool)}
+
+	select {
+	case l.calls <- call:
+	case <-l.cancel:
+		return false
+	}
+
+	// Poll has taken the call and runs it before returning.
+	<-call.done
+	return true
+}
+
+func (l *loader) Poll() {
+	// Run everything the builder is waiting on.
+	for polling := true; polling; {
+		select {
+		case call := <-l.calls:
+			call.fn()
+			close(call.done)
+		default:
+			polling = false
+		}
+	}
+
+	l.mutex.Lock()
+	if l.listener == nil || l.stopped {
+		l.mutex.Unlock()
+		return
+	}
+	progress, done, scene := l.progress, l.done, l.scene
+	notify := done && !l.notified
+	l.notified = l.notified || done
+	l.mutex.Unlock()
+
+	l.listener.LoadProgress(progress)
+
+	if notify {
+		l.listener.LoadComplete(scene)
+	}
+}
+
+func (l *loader) SetListener(listener api.ILoadListener) {
+	l.listener = listener
+}
+
+func (l *loader) IsDone() bool {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	return l.done
+}
+
+func (l *loader) Scene() api.INode {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	return l.scene
+}
+
+func (l *loader) Err() error {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	return l.err
+}
@@ -15,6 +15,10 @@ type dragState struct {
 	position     api.IPoint
 	delta        api.IPoint
 	mapPoint     api.IPoint
+
+	raise   bool
+	raised  api.INode
+	raisedZ int
 }
 
 // NewDragState returns a dragging state object
@@ -80,4 +84,25 @@ func (d *dragState) SetButtonStateUsing(x, y int32, button uint8, state uint32,
 	} else {
 		d.positionUp.SetByPoint(d.mapPoint)
 	}
+
+	if d.raise {
+		d.raiseNode(node)
+	}
+}
+
+func (d *dragState) SetRaiseOnDrag(raise bool) {
+	d.raise = raise
+}
+
+func (d *dragState) raiseNode(node api.INode) {
+	if d.active && d.dragging {
+		if d.raised == nil && node.HasParent() {
+			d.raised = node
+			d.raisedZ = node.ZIndex()
+			node.Parent().BringToFront(node)
+		}
+	} else if d.raised != nil {
+		d.raised.SetZIndex(d.raisedZ)
+		d.raised = nil
+	}
 }
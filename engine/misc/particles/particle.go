@@ -82,6 +82,9 @@ func (p *Particle) Update(dt float64) {
 	if p.active {
 		p.velocity.ApplyToPoint(p.position)
 		p.node.SetPosition(p.position.X(), p.position.Y())
+
+		// Fade out over the lifespan.
+		p.node.SetOpacity(1.0 - p.elapsed/p.lifespan)
 	}
 }
 
@@ -90,4 +93,5 @@ func (p *Particle) Reset() {
 	p.active = false
 	p.elapsed = 0.0
 	p.node.SetVisible(p.active)
+	p.node.SetOpacity(1.0)
 }
@@ -0,0 +1,55 @@
+package misc
+
+import (
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine/geometry"
+)
+
+// PolygonZone is a trigger region with inner and outer polygon
+// boundaries. The outer polygon should contain the inner one.
+type PolygonZone struct {
+	Zone
+
+	inner api.IPolygon
+	outer api.IPolygon
+
+	position api.IPoint
+	local    api.IPoint
+}
+
+// NewPolygonZone constructs a zone from built polygons whose vertices
+// are relative to the zone's position.
+func NewPolygonZone(inner, outer api.IPolygon) api.IZone {
+	o := new(PolygonZone)
+	o.InitializeZone()
+	o.inner = inner
+	o.outer = outer
+	o.position = geometry.NewPoint()
+	o.local = geometry.NewPoint()
+	return o
+}
+
+// SetPosition sets the location of zone.
+func (z *PolygonZone) SetPosition(x, y float64) {
+	z.position.SetByComp(x, y)
+}
+
+// Update changes zone's state based on point and returns new state
+func (z *PolygonZone) Update(position api.IPoint) (state int, stateChanged bool) {
+	return z.UpdateState(z.PointInside(position))
+}
+
+// PointInside checks if point is inside either polygon
+func (z *PolygonZone) PointInside(point api.IPoint) int {
+	z.local.SetByComp(point.X()-z.position.X(), point.Y()-z.position.Y())
+
+	if z.inner.PointInside(z.local) {
+		return api.ZoneStateEnteredInner
+	}
+
+	if z.outer.PointInside(z.local) {
+		return api.ZoneStateEnteredOuter
+	}
+
+	return api.ZoneStateObjectIsOutside
+}
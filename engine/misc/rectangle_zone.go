@@ -0,0 +1,60 @@
+package misc
+
+import (
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine/geometry"
+)
+
+// RectangleZone is a trigger region with inner and outer axis aligned
+// rectangles centered on the zone's position.
+type RectangleZone struct {
+	Zone
+
+	inner api.IRectangle
+	outer api.IRectangle
+
+	position api.IPoint
+	local    api.IPoint
+}
+
+// NewRectangleZone constructs a new RectangleZone
+func NewRectangleZone() api.IZone {
+	o := new(RectangleZone)
+	o.InitializeZone()
+	o.inner = geometry.NewRectangle()
+	o.outer = geometry.NewRectangle()
+	o.position = geometry.NewPoint()
+	o.local = geometry.NewPoint()
+	return o
+}
+
+// SetSizes sets the inner and outer rectangles' dimensions
+func (z *RectangleZone) SetSizes(innerWidth, innerHeight, outerWidth, outerHeight float64) {
+	z.inner.Set(-innerWidth/2.0, -innerHeight/2.0, innerWidth/2.0, innerHeight/2.0)
+	z.outer.Set(-outerWidth/2.0, -outerHeight/2.0, outerWidth/2.0, outerHeight/2.0)
+}
+
+// SetPosition sets the center location of zone.
+func (z *RectangleZone) SetPosition(x, y float64) {
+	z.position.SetByComp(x, y)
+}
+
+// Update changes zone's state based on point and returns new state
+func (z *RectangleZone) Update(position api.IPoint) (state int, stateChanged bool) {
+	return z.UpdateState(z.PointInside(position))
+}
+
+// PointInside checks if point is inside either rectangle
+func (z *RectangleZone) PointInside(point api.IPoint) int {
+	z.local.SetByComp(point.X()-z.position.X(), point.Y()-z.position.Y())
+
+	if z.inner.ContainsPoint(z.local) {
+		return api.ZoneStateEnteredInner
+	}
+
+	if z.outer.ContainsPoint(z.local) {
+		return api.ZoneStateEnteredOuter
+	}
+
+	return api.ZoneStateObjectIsOutside
+}
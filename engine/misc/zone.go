@@ -4,12 +4,12 @@ import (
 	"github.com/wdevore/RangerGo/api"
 )
 
-// Zone is a trigger region or area with an inner and out boundary
-// Zones collect subscribers such that the subscribers can be
-// notified of enter/exit states.
+// Zone is the inner/outer hysteresis of a single object crossing a
+// zone. Zone shapes embed it to track one object and a ZoneManager
+// keeps one per object and zone.
 type Zone struct {
-	prevState int
-	state     int // CrossState
+	region int // ZoneState region of the last update
+	state  int // CrossState
 
 	// inner region has been visited since being outside or exiting
 	innerAccessed bool
@@ -18,38 +18,33 @@ type Zone struct {
 // NewZone constructs a new Zone
 func NewZone() *Zone {
 	o := new(Zone)
+	o.InitializeZone()
 	return o
 }
 
 // InitializeZone initializes the base zone
 func (z *Zone) InitializeZone() {
 	z.state = api.CrossStateNone
-	z.prevState = api.CrossStateNone
+	z.region = api.ZoneStateObjectIsOutside
+	z.innerAccessed = false
 }
 
-// UpdateState checks if an object crossed into/out-of the zone, and updates
-// and returns the internal state. In addition, it will send messages to
-// listeners if state changed.
-func (z *Zone) UpdateState(currentState int) (state int, stateChanged bool) {
-	stateChanged = false
-
-	if z.prevState == api.ZoneStateEnteredOuter {
-		if currentState == api.ZoneStateEnteredInner && !z.innerAccessed {
-			// fmt.Println("zone: entered")
-			z.state = api.CrossStateEntered
-			z.innerAccessed = true
-			stateChanged = true
-		}
-	} else if currentState == api.ZoneStateObjectIsOutside {
-		if z.innerAccessed {
-			// fmt.Println("zone: exited")
-			z.state = api.CrossStateExited
-			z.innerAccessed = false
-			stateChanged = true
-		}
+// UpdateState takes the region an object is now in, as returned by
+// an IZone's PointInside, and updates and returns the crossing state
+// and whether it changed.
+func (z *Zone) UpdateState(region int) (state int, stateChanged bool) {
+	switch {
+	case region == api.ZoneStateEnteredInner && !z.innerAccessed:
+		z.state = api.CrossStateEntered
+		z.innerAccessed = true
+		stateChanged = true
+	case region == api.ZoneStateObjectIsOutside && z.innerAccessed:
+		z.state = api.CrossStateExited
+		z.innerAccessed = false
+		stateChanged = true
 	}
 
-	z.prevState = currentState
+	z.region = region
 
 	return z.state, stateChanged
 }
@@ -58,3 +53,13 @@ func (z *Zone) UpdateState(currentState int) (state int, stateChanged bool) {
 func (z *Zone) State() int {
 	return z.state
 }
+
+// Region returns the region from the last update
+func (z *Zone) Region() int {
+	return z.region
+}
+
+// Inside returns true between entering and exiting
+func (z *Zone) Inside() bool {
+	return z.innerAccessed
+}
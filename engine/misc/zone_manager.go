@@ -0,0 +1,154 @@
+package misc
+
+import (
+	"github.com/wdevore/RangerGo/api"
+)
+
+type zoneEntry struct {
+	id   int
+	zone api.IZone
+}
+
+type crossing struct {
+	zoneID   int
+	objectID int
+}
+
+// ZoneManager checks many objects against many zones. Each object has
+// its own inner/outer hysteresis per zone and subscribers are notified
+// with CrossStateEntered and CrossStateExited when an object crosses,
+// and CrossStateInside for every check in between.
+//
+// Objects and zones must share a space, for example, sibling nodes.
+type ZoneManager struct {
+	zones     []zoneEntry
+	crossings map[crossing]*Zone
+
+	listeners []api.IZoneListener
+
+	nodes []api.INode
+}
+
+// NewZoneManager constructs an empty ZoneManager
+func NewZoneManager() *ZoneManager {
+	o := new(ZoneManager)
+	o.crossings = map[crossing]*Zone{}
+	return o
+}
+
+// AddZone adds a zone identified by id
+func (m *ZoneManager) AddZone(id int, zone api.IZone) {
+	m.zones = append(m.zones, zoneEntry{id: id, zone: zone})
+}
+
+// RemoveZone removes a zone. Objects inside it are notified as exiting.
+func (m *ZoneManager) RemoveZone(id int) {
+	for i, z := range m.zones {
+		if z.id == id {
+			m.zones = append(m.zones[:i], m.zones[i+1:]...)
+			break
+		}
+	}
+
+	for key, state := range m.crossings {
+		if key.zoneID == id {
+			m.release(key, state)
+		}
+	}
+}
+
+// Subscribe adds a listener for zone events
+func (m *ZoneManager) Subscribe(listener api.IZoneListener) {
+	m.listeners = append(m.listeners, listener)
+}
+
+// Unsubscribe removes a listener
+func (m *ZoneManager) Unsubscribe(listener api.IZoneListener) {
+	for i, l := range m.listeners {
+		if l == listener {
+			m.listeners = append(m.listeners[:i], m.listeners[i+1:]...)
+			return
+		}
+	}
+}
+
+// Track adds a node, identified by its ID, that Update checks.
+func (m *ZoneManager) Track(node api.INode) {
+	m.nodes = append(m.nodes, node)
+}
+
+// Untrack removes a tracked node and forgets it.
+func (m *ZoneManager) Untrack(node api.INode) {
+	for i, n := range m.nodes {
+		if n == node {
+			m.nodes = append(m.nodes[:i], m.nodes[i+1:]...)
+			break
+		}
+	}
+
+	m.Forget(node.ID())
+}
+
+// Forget drops an object's crossings. It is notified as exiting any
+// zone it is inside.
+func (m *ZoneManager) Forget(objectID int) {
+	for key, state := range m.crossings {
+		if key.objectID == objectID {
+			m.release(key, state)
+		}
+	}
+}
+
+// Inside returns true if an object has entered, and not exited, a zone.
+func (m *ZoneManager) Inside(zoneID, objectID int) bool {
+	state, ok := m.crossings[crossing{zoneID: zoneID, objectID: objectID}]
+	return ok && state.Inside()
+}
+
+// Update checks every tracked node at its position
+func (m *ZoneManager) Update() {
+	for _, node := range m.nodes {
+		m.Check(node.ID(), node.Position())
+	}
+}
+
+// Check updates an object's crossings at a position, for example, a
+// Box2D body's, and notifies subscribers.
+func (m *ZoneManager) Check(objectID int, position api.IPoint) {
+	for _, z := range m.zones {
+		key := crossing{zoneID: z.id, objectID: objectID}
+
+		state, ok := m.crossings[key]
+		if !ok {
+			state = NewZone()
+			m.crossings[key] = state
+		}
+
+		crossState, changed := state.UpdateState(z.zone.PointInside(position))
+
+		if changed {
+			m.notify(crossState, key)
+		} else if state.Inside() {
+			m.notify(api.CrossStateInside, key)
+		}
+
+		if !state.Inside() && state.Region() == api.ZoneStateObjectIsOutside {
+			// Nothing to remember while outside.
+			delete(m.crossings, key)
+		}
+	}
+}
+
+func (m *ZoneManager) release(key crossing, state *Zone) {
+	delete(m.crossings, key)
+
+	if state.Inside() {
+		m.notify(api.CrossStateExited, key)
+	}
+}
+
+func (m *ZoneManager) notify(state int, key crossing) {
+	for _, listener := range m.listeners {
+		listener.Notify(state, key.zoneID, key.objectID)
+	}
+}
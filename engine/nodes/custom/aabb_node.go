@@ -48,6 +48,11 @@ func (a *AABBNode) SetColor(color api.IPalette) {
 	a.color = color
 }
 
+// Color returns the node's color
+func (a *AABBNode) Color() api.IPalette {
+	return a.color
+}
+
 // SetBounds sets the bounds based on the provided Mesh
 func (a *AABBNode) SetBounds(mesh api.IMesh) {
 	a.aabb.SetBounds(mesh.Vertices())
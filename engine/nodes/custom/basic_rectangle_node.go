@@ -52,6 +52,11 @@ func (r *BasicRectangleNode) SetColor(color api.IPalette) {
 	r.color = color
 }
 
+// Color returns the node's color
+func (r *BasicRectangleNode) Color() api.IPalette {
+	return r.color
+}
+
 // Draw renders shape
 func (r *BasicRectangleNode) Draw(context api.IRenderContext) {
 	if r.IsDirty() {
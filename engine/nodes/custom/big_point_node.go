@@ -44,6 +44,11 @@ func (b *BigPointNode) SetColor(color api.IPalette) {
 	b.lineColor = color
 }
 
+// Color returns the node's color
+func (b *BigPointNode) Color() api.IPalette {
+	return b.lineColor
+}
+
 // SetPoint sets the center position
 func (b *BigPointNode) SetPoint(x, y float64) {
 	b.p1.SetByComp(x, y)
@@ -0,0 +1,292 @@
+package custom
+
+import (
+	"fmt"
+
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine/geometry"
+	"github.com/wdevore/RangerGo/engine/maths"
+	"github.com/wdevore/RangerGo/engine/misc"
+	"github.com/wdevore/RangerGo/engine/nodes"
+)
+
+type cameraViewport struct {
+	camera api.ICamera
+	// rect is in device-space. nil is the whole window.
+	rect   api.IRectangle
+	target api.INode
+}
+
+// CameraNode renders its children through one or more cameras. Each
+// camera has a viewport, which is a device-space rectangle that the
+// rendering is clipped to, for example, split-screen or a minimap.
+//
+// The active viewport is the one under the mouse, and it is the one
+// used by CalcTransform and the space mappings.
+// The node's own position, rotation and scale are not used.
+type CameraNode struct {
+	nodes.Node
+
+	viewports []*cameraViewport
+	active    int
+
+	// The viewport and camera transform last applied to the children
+	applied   int
+	transform api.IAffineTransform
+
+	targetPoint api.IPoint
+	devicePoint api.IPoint
+}
+
+// NewCameraNode constructs a camera node with one camera that views
+// the whole window.
+func NewCameraNode(name string, world api.IWorld, parent api.INode) api.INode {
+	o := new(CameraNode)
+	o.Initialize(name)
+	o.SetParent(parent)
+	parent.AddChild(o)
+	o.Build(world)
+	return o
+}
+
+// Build configures the node
+func (c *CameraNode) Build(world api.IWorld) {
+	c.Node.Build(world)
+
+	c.targetPoint = geometry.NewPoint()
+	c.devicePoint = geometry.NewPoint()
+	c.transform = maths.NewTransform()
+	c.applied = -1
+
+	vp := new(cameraViewport)
+	vp.camera = misc.NewCamera()
+	vp.camera.SetViewSize(world.ViewSize().Components())
+	c.viewports = append(c.viewports, vp)
+}
+
+// --------------------------------------------------------
+// Viewports
+// --------------------------------------------------------
+
+// AddViewport adds a camera that renders into a device-space rectangle
+// and returns the viewport's index.
+func (c *CameraNode) AddViewport(x, y, w, h float64) int {
+	vp := new(cameraViewport)
+	vp.camera = misc.NewCamera()
+	c.viewports = append(c.viewports, vp)
+
+	index := len(c.viewports) - 1
+	c.SetViewport(index, x, y, w, h)
+
+	return index
+}
+
+// SetViewport moves a viewport to a device-space rectangle
+func (c *CameraNode) SetViewport(index int, x, y, w, h float64) {
+	vp := c.viewports[index]
+	vp.rect = geometry.NewRectangleUsing(x, y, x+w, y+h)
+
+	rx, ry := c.viewRatio()
+	vp.camera.SetViewSize(w/rx, h/ry)
+
+	// The view-space differs even if the camera's transform doesn't.
+	c.applied = -1
+}
+
+// Viewports returns the number of viewports
+func (c *CameraNode) Viewports() int {
+	return len(c.viewports)
+}
+
+// Camera returns a viewport's camera
+func (c *CameraNode) Camera(index int) api.ICamera {
+	return c.viewports[index].camera
+}
+
+// Follow makes a viewport's camera track a descendant node. nil stops following.
+func (c *CameraNode) Follow(index int, target api.INode) {
+	c.viewports[index].target = target
+}
+
+// ActiveViewport returns the index of the active viewport
+func (c *CameraNode) ActiveViewport() int {
+	return c.active
+}
+
+// SetActiveViewport selects the viewport used for mappings
+func (c *CameraNode) SetActiveViewport(index int) {
+	c.active = index
+	c.RippleDirty(true)
+}
+
+// ViewportAt returns the top most viewport containing the device-space
+// point, or -1.
+func (c *CameraNode) ViewportAt(dvx, dvy int32) int {
+	c.devicePoint.SetByComp(float64(dvx), float64(dvy))
+
+	for i := len(c.viewports) - 1; i >= 0; i-- {
+		rect := c.viewports[i].rect
+		if rect == nil || rect.ContainsPoint(c.devicePoint) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func (c *CameraNode) viewRatio() (x, y float64) {
+	ws, vs := c.World().WindowSize(), c.World().ViewSize()
+	return ws.X() / vs.X(), ws.Y() / vs.Y()
+}
+
+// --------------------------------------------------------
+// Timing
+// --------------------------------------------------------
+
+// Update moves each camera towards its target
+func (c *CameraNode) Update(msPerUpdate, secPerUpdate float64) {
+	for _, vp := range c.viewports {
+		if vp.target != nil {
+			c.mapToCamera(vp.target, c.targetPoint)
+			vp.camera.Track(c.targetPoint.X(), c.targetPoint.Y())
+		}
+
+		vp.camera.Update(msPerUpdate)
+	}
+}
+
+// mapToCamera maps a descendant's origin into camera-space
+func (c *CameraNode) mapToCamera(node api.INode, point api.IPoint) {
+	point.SetByComp(0.0, 0.0)
+
+	for n := node; n != nil && n != api.INode(c); n = n.Parent() {
+		n.CalcTransform().TransformCompToPoint(point.X(), point.Y(), point)
+	}
+}
+
+// EnterNode called when a node is entering the stage
+func (c *CameraNode) EnterNode(man api.INodeManager) {
+	man.RegisterTarget(c)
+	man.RegisterEventTarget(c)
+}
+
+// ExitNode called when a node is exiting stage
+func (c *CameraNode) ExitNode(man api.INodeManager) {
+	man.UnRegisterTarget(c)
+	man.UnRegisterEventTarget(c)
+}
+
+// --------------------------------------------------------
+// Transforms and visiting
+// --------------------------------------------------------
+
+// CalcTransform returns the active camera's transform
+func (c *CameraNode) CalcTransform() api.IAffineTransform {
+	return c.viewports[c.active].camera.Transform()
+}
+
+// Visit renders the children once per viewport
+func (c *CameraNode) Visit(context api.IRenderContext, interpolation float64) {
+	if !c.IsVisible() {
+		return
+	}
+
+	for i, vp := range c.viewports {
+		context.Save()
+
+		nodes.ApplyBlending(c, context)
+
+		context.SetViewport(vp.rect)
+
+		vp.camera.Interpolate(interpolation)
+		context.Apply(vp.camera.Transform())
+
+		// Children cache device-space vertices while they aren't dirty,
+		// but those differ per viewport and whenever a camera moves.
+		if c.viewChanged(i) {
+			c.RippleDirty(true)
+		}
+
+		for _, child := range c.Children() {
+			visitor, isVisitorType := child.(api.IVisitor)
+			if isVisitorType {
+				visitor.Visit(context, interpolation)
+			} else {
+				nodes.Visit(child, context, interpolation)
+			}
+		}
+
+		context.Restore()
+	}
+}
+
+// viewChanged records the viewport and camera transform being applied
+// to the children and returns true if they differ from the last ones.
+func (c *CameraNode) viewChanged(index int) bool {
+	a, b, cc, d, tx, ty := c.viewports[index].camera.Transform().Components()
+	la, lb, lc, ld, ltx, lty := c.transform.Components()
+
+	if index == c.applied && a == la && b == lb && cc == lc && d == ld && tx == ltx && ty == lty {
+		return false
+	}
+
+	c.applied = index
+	c.transform.SetByComp(a, b, cc, d, tx, ty)
+	return true
+}
+
+// --------------------------------------------------------
+// Space mappings
+// --------------------------------------------------------
+
+// MapDeviceToView maps device-space to view-space using the active viewport
+func (c *CameraNode) MapDeviceToView(dvx, dvy int32, viewPoint api.IPoint) {
+	rect := c.viewports[c.active].rect
+
+	if rect == nil {
+		c.World().InvViewSpace().TransformCompToPoint(float64(dvx), float64(dvy), viewPoint)
+		return
+	}
+
+	rx, ry := c.viewRatio()
+	w, h := rect.Dimesions()
+	viewPoint.SetByComp(
+		(float64(dvx)-rect.Min().X()-w/2.0)/rx,
+		(float64(dvy)-rect.Min().Y()-h/2.0)/ry)
+}
+
+// MapViewToDevice maps view-space to device-space using the active viewport
+func (c *CameraNode) MapViewToDevice(viewPoint, devicePoint api.IPoint) {
+	rect := c.viewports[c.active].rect
+
+	if rect == nil {
+		c.World().ViewSpace().TransformToPoint(viewPoint, devicePoint)
+		return
+	}
+
+	rx, ry := c.viewRatio()
+	w, h := rect.Dimesions()
+	devicePoint.SetByComp(
+		viewPoint.X()*rx+rect.Min().X()+w/2.0,
+		viewPoint.Y()*ry+rect.Min().Y()+h/2.0)
+}
+
+// -----------------------------------------------------
+// IO events
+// -----------------------------------------------------
+
+// Handle activates the viewport under the mouse
+func (c *CameraNode) Handle(event api.IEvent) bool {
+	if event.GetType() == api.IOTypeMouseMotion {
+		mx, my := event.GetMousePosition()
+		if i := c.ViewportAt(mx, my); i >= 0 && i != c.active {
+			c.SetActiveViewport(i)
+		}
+	}
+
+	return false
+}
+
+func (c CameraNode) String() string {
+	return fmt.Sprintf("%s (%d viewports)", c.Node, len(c.viewports))
+}
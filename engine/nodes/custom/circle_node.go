@@ -75,6 +75,11 @@ func (c *CircleNode) SetColor(color api.IPalette) {
 	c.color = color
 }
 
+// Color returns the node's color
+func (c *CircleNode) Color() api.IPalette {
+	return c.color
+}
+
 // Draw renders shape
 func (c *CircleNode) Draw(context api.IRenderContext) {
 	if c.IsDirty() {
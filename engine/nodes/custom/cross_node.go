@@ -56,6 +56,11 @@ func (c *CrossNode) SetColor(color api.IPalette) {
 	c.color = color
 }
 
+// Color returns the node's color
+func (c *CrossNode) Color() api.IPalette {
+	return c.color
+}
+
 // Draw renders shape
 func (c *CrossNode) Draw(context api.IRenderContext) {
 	if c.IsDirty() {
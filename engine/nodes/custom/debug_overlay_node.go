@@ -0,0 +1,85 @@
+package custom
+
+import (
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine/nodes"
+	"github.com/wdevore/RangerGo/engine/rendering"
+)
+
+// DebugOverlayNode shows an api.IDescriber's description, for example,
+// a state machine's current state, refreshing it each update.
+type DebugOverlayNode struct {
+	nodes.Node
+
+	source      api.IDescriber
+	description string
+
+	text *VectorTextNode
+}
+
+// NewDebugOverlayNode constructs an overlay with nothing to describe
+func NewDebugOverlayNode(name string, world api.IWorld, parent api.INode) api.INode {
+	o := new(DebugOverlayNode)
+	o.Initialize(name)
+	o.SetParent(parent)
+	parent.AddChild(o)
+	o.Build(world)
+	return o
+}
+
+// Build configures the node
+func (d *DebugOverlayNode) Build(world api.IWorld) {
+	d.Node.Build(world)
+
+	d.text = NewVectorTextNode(world, d)
+	d.text.Initialize(d.Name() + " Text")
+	d.text.SetParent(d)
+	d.text.SetColor(rendering.NewPaletteInt64(rendering.Yellow))
+	d.text.SetScale(10.0)
+}
+
+// SetSource sets what to describe
+func (d *DebugOverlayNode) SetSource(source api.IDescriber) {
+	d.source = source
+	d.refresh()
+}
+
+// Text returns the text node for changing its color, scale or
+// alignment.
+func (d *DebugOverlayNode) Text() *VectorTextNode {
+	return d.text
+}
+
+func (d *DebugOverlayNode) refresh() {
+	if d.source == nil {
+		return
+	}
+
+	if description := d.source.Describe(); description != d.description {
+		d.description = description
+		d.text.SetText(description)
+	}
+}
+
+// -----------------------------------------------------
+// Timing
+// -----------------------------------------------------
+
+// Update refreshes the description
+func (d *DebugOverlayNode) Update(msPerUpdate, secPerUpdate float64) {
+	d.refresh()
+}
+
+// -----------------------------------------------------
+// Node lifecycles
+// -----------------------------------------------------
+
+// EnterNode called when a node is entering the stage
+func (d *DebugOverlayNode) EnterNode(man api.INodeManager) {
+	man.RegisterTarget(d)
+}
+
+// ExitNode called when a node is exiting stage
+func (d *DebugOverlayNode) ExitNode(man api.INodeManager) {
+	man.UnRegisterTarget(d)
+}
@@ -0,0 +1,197 @@
+package custom
+
+import (
+	"fmt"
+
+	"github.com/veandco/go-sdl2/sdl"
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine/geometry"
+	"github.com/wdevore/RangerGo/engine/nodes"
+	"github.com/wdevore/RangerGo/engine/rendering"
+)
+
+// LayerNode renders its children into an offscreen, window sized,
+// texture and then composites the texture using the node's opacity and
+// blend mode, and a tint. Because the children are composited as one
+// image overlapping children fade together, for example, when
+// cross-fading a scene.
+//
+// A cached layer only re-renders its children when one of them is
+// dirty or the layer has moved, which makes static content, such as
+// backgrounds, cheap.
+type LayerNode struct {
+	nodes.Node
+
+	texture *sdl.Texture
+
+	tint api.IPalette
+
+	cached bool
+	// valid indicates the texture reflects the children
+	valid bool
+
+	// Device-space reference points used to detect movement.
+	origin, unitX, unitY api.IPoint
+	point                api.IPoint
+}
+
+// NewLayerNode constructs an opaque, uncached, layer node
+func NewLayerNode(name string, world api.IWorld, parent api.INode) api.INode {
+	o := new(LayerNode)
+	o.Initialize(name)
+	o.SetParent(parent)
+	parent.AddChild(o)
+	o.Build(world)
+	return o
+}
+
+// Build configures the node
+func (l *LayerNode) Build(world api.IWorld) {
+	l.Node.Build(world)
+
+	l.tint = rendering.NewPaletteInt64(rendering.White)
+
+	l.origin = geometry.NewPoint()
+	l.unitX = geometry.NewPoint()
+	l.unitY = geometry.NewPoint()
+	l.point = geometry.NewPoint()
+}
+
+// SetTint sets the color the layer is modulated by. White is no tint.
+func (l *LayerNode) SetTint(tint api.IPalette) {
+	l.tint = tint
+}
+
+// SetCached enables re-using the rendering while the children aren't dirty
+func (l *LayerNode) SetCached(cached bool) {
+	l.cached = cached
+	l.valid = false
+}
+
+// Invalidate forces the children to be rendered on the next visit, for
+// example, when a child changes without being marked dirty.
+func (l *LayerNode) Invalidate() {
+	l.valid = false
+}
+
+// ExitNode called when a node is exiting stage
+func (l *LayerNode) ExitNode(man api.INodeManager) {
+	if l.texture != nil {
+		l.texture.Destroy()
+		l.texture = nil
+		l.valid = false
+	}
+}
+
+// Visit renders the children into the texture, when required, and
+// composites it.
+func (l *LayerNode) Visit(context api.IRenderContext, interpolation float64) {
+	if !l.IsVisible() {
+		return
+	}
+
+	context.Save()
+
+	nodes.ApplyBlending(l, context)
+
+	l.Interpolate(interpolation)
+	context.Apply(l.CalcTransform())
+	l.SetDirty(false)
+
+	if l.needsRender(context, interpolation) {
+		l.render(context, interpolation)
+	}
+
+	context.Composite(l.texture, l.tint)
+
+	context.Restore()
+}
+
+func (l *LayerNode) needsRender(context api.IRenderContext, interpolation float64) bool {
+	if !l.cached || !l.valid || l.texture == nil {
+		return true
+	}
+
+	moved := l.track(context, 0.0, 0.0, l.origin)
+	moved = l.track(context, 1.0, 0.0, l.unitX) || moved
+	moved = l.track(context, 0.0, 1.0, l.unitY) || moved
+	if moved {
+		return true
+	}
+
+	// Children may become dirty while interpolating.
+	for _, child := range l.Children() {
+		if interpolateTree(child, interpolation) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// track updates a device-space reference point and returns true if it changed.
+func (l *LayerNode) track(context api.IRenderContext, x, y float64, reference api.IPoint) bool {
+	l.point.SetByComp(x, y)
+	context.TransformPoint(l.point, l.point)
+
+	if l.point.X() == reference.X() && l.point.Y() == reference.Y() {
+		return false
+	}
+
+	reference.SetByPoint(l.point)
+	return true
+}
+
+// interpolateTree interpolates the visible nodes and returns true if any
+// are dirty.
+func interpolateTree(node api.INode, interpolation float64) bool {
+	if !node.IsVisible() {
+		return false
+	}
+
+	node.Interpolate(interpolation)
+	dirty := node.IsDirty()
+
+	for _, child := range node.Children() {
+		dirty = interpolateTree(child, interpolation) || dirty
+	}
+
+	return dirty
+}
+
+func (l *LayerNode) render(context api.IRenderContext, interpolation float64) {
+	if l.texture == nil {
+		w, h := l.World().WindowSize().ComponentsAsInt32()
+		texture, err := l.World().Renderer().CreateTexture(
+			sdl.PIXELFORMAT_RGBA8888, sdl.TEXTUREACCESS_TARGET, w, h)
+		if err != nil {
+			fmt.Println("LayerNode: unable to create target texture: ", err)
+			return
+		}
+		l.texture = texture
+	}
+
+	context.Save()
+	context.SetTarget(l.texture)
+
+	// The opacity and blend mode are applied when compositing.
+	context.SetOpacity(1.0)
+	context.SetBlendMode(api.BlendAlpha)
+
+	for _, child := range l.Children() {
+		visitor, isVisitorType := child.(api.IVisitor)
+		if isVisitorType {
+			visitor.Visit(context, interpolation)
+		} else {
+			nodes.Visit(child, context, interpolation)
+		}
+	}
+
+	context.Restore()
+
+	l.valid = true
+}
+
+func (l LayerNode) String() string {
+	return fmt.Sprintf("%s (opacity: %0.2f, cached: %v)", l.Node, l.Opacity(), l.cached)
+}
@@ -47,6 +47,11 @@ func (l *LineNode) SetColor(color api.IPalette) {
 	l.lineColor = color
 }
 
+// Color returns the node's color
+func (l *LineNode) Color() api.IPalette {
+	return l.lineColor
+}
+
 // SetPoints sets the start and end points of the line.
 func (l *LineNode) SetPoints(x1, y1, x2, y2 float64) {
 	l.p1.SetByComp(x1, y1)
@@ -0,0 +1,95 @@
+package custom
+
+import (
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine/geometry"
+	"github.com/wdevore/RangerGo/engine/nodes"
+	"github.com/wdevore/RangerGo/engine/rendering"
+)
+
+// NavigationNode renders an api.INavigator's graph, and optionally a
+// path, for debugging navigation.
+type NavigationNode struct {
+	nodes.Node
+
+	color     api.IPalette
+	pathColor api.IPalette
+
+	edges api.IMesh
+	path  api.IPolygon
+}
+
+// NewNavigationNode constructs a navigation rendering node
+func NewNavigationNode(name string, world api.IWorld, parent api.INode) api.INode {
+	o := new(NavigationNode)
+	o.Initialize(name)
+	o.SetParent(parent)
+	parent.AddChild(o)
+	o.Build(world)
+	return o
+}
+
+// Build configures the node
+func (n *NavigationNode) Build(world api.IWorld) {
+	n.Node.Build(world)
+
+	n.edges = geometry.NewMesh()
+	n.path = geometry.NewPolygon()
+
+	n.color = rendering.NewPaletteInt64(rendering.DarkGray)
+	n.pathColor = rendering.NewPaletteInt64(rendering.Yellow)
+}
+
+// SetNavigator captures the navigator's graph. Call it again if the
+// graph changes.
+func (n *NavigationNode) SetNavigator(navigator api.INavigator) {
+	n.edges.Clear()
+	for _, p := range navigator.Edges() {
+		n.edges.AddVertex(p.X(), p.Y())
+	}
+	n.edges.Build()
+	n.SetDirty(true)
+}
+
+// SetPath sets the path to render, nil for none.
+func (n *NavigationNode) SetPath(points []api.IPoint) {
+	mesh := n.path.Mesh()
+	mesh.Clear()
+	for _, p := range points {
+		mesh.AddVertex(p.X(), p.Y())
+	}
+	n.path.Build()
+	n.SetDirty(true)
+}
+
+// SetColor sets the graph's color
+func (n *NavigationNode) SetColor(color api.IPalette) {
+	n.color = color
+}
+
+// Color returns the graph's color
+func (n *NavigationNode) Color() api.IPalette {
+	return n.color
+}
+
+// SetPathColor sets the path's color
+func (n *NavigationNode) SetPathColor(color api.IPalette) {
+	n.pathColor = color
+}
+
+// Draw renders the graph and path
+func (n *NavigationNode) Draw(context api.IRenderContext) {
+	if n.IsDirty() {
+		context.TransformMesh(n.edges)
+		context.TransformPolygon(n.path)
+		n.SetDirty(false)
+	}
+
+	context.SetDrawColor(n.color)
+	context.RenderLines(n.edges)
+
+	if len(n.path.Mesh().Vertices()) > 1 {
+		context.SetDrawColor(n.pathColor)
+		context.RenderPolygon(n.path, api.OPEN)
+	}
+}
@@ -0,0 +1,133 @@
+package custom
+
+import (
+	"math"
+
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine/geometry"
+	"github.com/wdevore/RangerGo/engine/nodes"
+	"github.com/wdevore/RangerGo/engine/rendering"
+)
+
+// PathNode renders an api.IPath, and optionally its points, for
+// debugging paths.
+type PathNode struct {
+	nodes.Node
+
+	color      api.IPalette
+	pointColor api.IPalette
+
+	path       api.IPath
+	spacing    float64
+	showPoints bool
+
+	polygon api.IPolygon
+	points  api.IMesh
+
+	// Device-space corners of a point's marker
+	o1, o2 api.IPoint
+}
+
+// NewPathNode constructs a path rendering node
+func NewPathNode(name string, world api.IWorld, parent api.INode) api.INode {
+	o := new(PathNode)
+	o.Initialize(name)
+	o.SetParent(parent)
+	parent.AddChild(o)
+	o.Build(world)
+	return o
+}
+
+// Build configures the node
+func (p *PathNode) Build(world api.IWorld) {
+	p.Node.Build(world)
+
+	p.spacing = 5.0
+	p.polygon = geometry.NewPolygon()
+	p.points = geometry.NewMesh()
+
+	p.o1 = geometry.NewPoint()
+	p.o2 = geometry.NewPoint()
+
+	p.color = rendering.NewPaletteInt64(rendering.LightGray)
+	p.pointColor = rendering.NewPaletteInt64(rendering.Orange)
+}
+
+// SetPath samples a built path. Call it again if the path changes.
+func (p *PathNode) SetPath(path api.IPath) {
+	p.path = path
+
+	mesh := p.polygon.Mesh()
+	mesh.Clear()
+
+	samples := int(math.Ceil(path.Length() / p.spacing))
+	if samples < 1 {
+		samples = 1
+	}
+
+	pt := geometry.NewPoint()
+	for i := 0; i <= samples; i++ {
+		path.PointAt(path.Length()*float64(i)/float64(samples), pt)
+		mesh.AddVertex(pt.X(), pt.Y())
+	}
+	p.polygon.Build()
+
+	p.points.Clear()
+	for _, pt := range path.Points() {
+		p.points.AddVertex(pt.X(), pt.Y())
+	}
+	p.points.Build()
+
+	p.SetDirty(true)
+}
+
+// SetSpacing sets the distance between samples, default 5.0. Call
+// before SetPath.
+func (p *PathNode) SetSpacing(spacing float64) {
+	p.spacing = spacing
+}
+
+// ShowPoints enables drawing the path's points
+func (p *PathNode) ShowPoints(show bool) {
+	p.showPoints = show
+}
+
+// SetColor sets the path's color
+func (p *PathNode) SetColor(color api.IPalette) {
+	p.color = color
+}
+
+// Color returns the path's color
+func (p *PathNode) Color() api.IPalette {
+	return p.color
+}
+
+// SetPointColor sets the color of the path's points
+func (p *PathNode) SetPointColor(color api.IPalette) {
+	p.pointColor = color
+}
+
+// Draw renders the path
+func (p *PathNode) Draw(context api.IRenderContext) {
+	if p.path == nil {
+		return
+	}
+
+	if p.IsDirty() {
+		context.TransformPolygon(p.polygon)
+		context.TransformMesh(p.points)
+		p.SetDirty(false)
+	}
+
+	context.SetDrawColor(p.color)
+	context.RenderPolygon(p.polygon, api.OPEN)
+
+	if p.showPoints {
+		context.SetDrawColor(p.pointColor)
+		for _, v := range p.points.Bucket() {
+			p.o1.SetByComp(v.X()-3.0, v.Y()-3.0)
+			p.o2.SetByComp(v.X()+3.0, v.Y()+3.0)
+			context.RenderAARectangle(p.o1, p.o2, api.FILLED)
+		}
+	}
+}
@@ -61,6 +61,11 @@ func (r *PolygonNode) SetColor(color api.IPalette) {
 	r.color = color
 }
 
+// Color returns the node's color
+func (r *PolygonNode) Color() api.IPalette {
+	return r.color
+}
+
 // SetOpen opens or closed the polygon during rendering
 func (r *PolygonNode) SetOpen(open bool) {
 	r.isOpen = open
@@ -60,6 +60,11 @@ func (r *RasterTextNode) SetColor(color api.IPalette) {
 	r.color = color
 }
 
+// Color returns the node's color
+func (r *RasterTextNode) Color() api.IPalette {
+	return r.color
+}
+
 // SetFontScale sets the scale factor of the font not the Node.
 func (r *RasterTextNode) SetFontScale(scale int) {
 	r.scale = scale
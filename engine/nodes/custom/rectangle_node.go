@@ -60,6 +60,11 @@ func (r *RectangleNode) SetColor(color api.IPalette) {
 	r.color = color
 }
 
+// Color returns the node's color
+func (r *RectangleNode) Color() api.IPalette {
+	return r.color
+}
+
 // SetBounds sets the min,max of rectangle
 func (r *RectangleNode) SetBounds(minx, miny, maxx, maxy float64) {
 }
@@ -0,0 +1,163 @@
+package custom
+
+import (
+	"fmt"
+
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine/geometry"
+	"github.com/wdevore/RangerGo/engine/nodes"
+	"github.com/wdevore/RangerGo/engine/rendering"
+)
+
+// sceneLoading shows a loader's progress and replaces itself with the
+// loaded scene once the loader is done and the minimum display time,
+// set via SetPauseTime, has passed.
+type sceneLoading struct {
+	nodes.Node
+	nodes.Scene
+	nodes.Transition
+
+	loader   api.ILoader
+	progress float64
+	loaded   bool
+
+	barColor  api.IPalette
+	fillColor api.IPalette
+
+	// View-space corners of the bar
+	barMin, barMax api.IPoint
+	fillMax        api.IPoint
+
+	// Device-space corners
+	o1, o2, o3 api.IPoint
+}
+
+// NewLoadingScene returns an IScene node that starts the loader when
+// it enters the stage.
+func NewLoadingScene(name string, world api.IWorld, loader api.ILoader) api.INode {
+	o := new(sceneLoading)
+	o.Initialize(name)
+	o.loader = loader
+	o.Build(world)
+	return o
+}
+
+// Build configures the node
+func (s *sceneLoading) Build(world api.IWorld) {
+	s.Node.Build(world)
+
+	vw, _ := world.ViewSize().Components()
+	w := vw / 2.0
+	h := 20.0
+
+	s.barMin = geometry.NewPointUsing(-w/2.0, -h/2.0)
+	s.barMax = geometry.NewPointUsing(w/2.0, h/2.0)
+	s.fillMax = geometry.NewPoint()
+
+	s.o1 = geometry.NewPoint()
+	s.o2 = geometry.NewPoint()
+	s.o3 = geometry.NewPoint()
+
+	s.barColor = rendering.NewPaletteInt64(rendering.LightGray)
+	s.fillColor = rendering.NewPaletteInt64(rendering.Orange)
+
+	label := NewVectorTextNode(world, s)
+	label.Initialize("Loading Label")
+	label.SetParent(s)
+	label.SetText("LOADING")
+	label.SetAlignment(api.TextAlignCenter)
+	label.SetScale(3.0)
+	label.SetPosition(0.0, -h*2.0)
+}
+
+// --------------------------------------------------------
+// Loading
+// --------------------------------------------------------
+
+// LoadProgress records the loader's progress
+func (s *sceneLoading) LoadProgress(fraction float64) {
+	s.progress = fraction
+}
+
+// LoadComplete takes the loaded scene as the replacement. If the
+// builder failed the loading scene stays and reports the error.
+func (s *sceneLoading) LoadComplete(scene api.INode) {
+	if scene == nil {
+		fmt.Println("LoadingScene: no scene to load: ", s.loader.Err())
+		return
+	}
+
+	s.SetReplacement(scene)
+	s.loaded = true
+}
+
+// --------------------------------------------------------
+// Timing
+// --------------------------------------------------------
+
+// Update polls the loader
+func (s *sceneLoading) Update(msPerUpdate, secPerUpdate float64) {
+	s.loader.Poll()
+	s.Transition.UpdateTransition(msPerUpdate)
+}
+
+// --------------------------------------------------------
+// Transitioning
+// --------------------------------------------------------
+
+// TransitionAction replaces the scene once loaded
+func (s *sceneLoading) TransitionAction() int {
+	if s.loaded && s.Transition.ReadyToTransition() {
+		return api.SceneReplaceTake
+	}
+
+	return api.SceneNoAction
+}
+
+// -----------------------------------------------------
+// Scene lifecycles
+// -----------------------------------------------------
+
+// EnterNode called when a node is entering the stage
+func (s *sceneLoading) EnterNode(man api.INodeManager) {
+	s.Transition.Reset()
+	man.RegisterTarget(s)
+
+	s.loader.SetListener(s)
+	s.loader.Start()
+}
+
+// ExitNode called when a node is exiting stage. An unfinished loader
+// is stopped so its builder doesn't wait on Calls forever.
+func (s *sceneLoading) ExitNode(man api.INodeManager) {
+	man.UnRegisterTarget(s)
+	s.loader.Stop()
+}
+
+// -----------------------------------------------------
+// Visuals
+// -----------------------------------------------------
+
+// Draw renders the progress bar
+func (s *sceneLoading) Draw(context api.IRenderContext) {
+	if s.IsDirty() {
+		context.TransformPoint(s.barMin, s.o1)
+		context.TransformPoint(s.barMax, s.o2)
+		s.SetDirty(false)
+	}
+
+	// The fill changes every frame.
+	w := s.barMax.X() - s.barMin.X()
+	s.fillMax.SetByComp(s.barMin.X()+w*s.progress, s.barMax.Y())
+	context.TransformPoint(s.fillMax, s.o3)
+
+	context.SetDrawColor(s.fillColor)
+	context.RenderAARectangle(s.o1, s.o3, api.FILLED)
+
+	context.SetDrawColor(s.barColor)
+	context.RenderAARectangle(s.o1, s.o2, api.OUTLINED)
+}
+
+func (s sceneLoading) String() string {
+	return fmt.Sprintf("%s (%0.0f%%)", s.Node, s.progress*100.0)
+}
@@ -0,0 +1,35 @@
+package custom
+
+import "strings"
+
+// wrapText splits text on newlines and then on word boundaries whenever
+// a line, as measured by "measure", exceeds maxWidth. A maxWidth <= 0
+// only splits on newlines.
+func wrapText(text string, maxWidth float64, measure func(line string) float64) []string {
+	lines := []string{}
+
+	for _, paragraph := range strings.Split(text, "\n") {
+		if maxWidth <= 0.0 {
+			lines = append(lines, paragraph)
+			continue
+		}
+
+		line := ""
+		for _, word := range strings.Split(paragraph, " ") {
+			candidate := word
+			if line != "" {
+				candidate = line + " " + word
+			}
+
+			if line != "" && measure(candidate) > maxWidth {
+				lines = append(lines, line)
+				line = word
+			} else {
+				line = candidate
+			}
+		}
+		lines = append(lines, line)
+	}
+
+	return lines
+}
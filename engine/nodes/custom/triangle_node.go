@@ -50,6 +50,11 @@ func (t *TriangleNode) SetColor(color api.IPalette) {
 	t.color = color
 }
 
+// Color returns the node's color
+func (t *TriangleNode) Color() api.IPalette {
+	return t.color
+}
+
 // SetPoints sets the edge points of the triangle
 func (t *TriangleNode) SetPoints(x1, y1, x2, y2, x3, y3 float64) {
 	t.polygon.SetVertex(x1, y1, 0)
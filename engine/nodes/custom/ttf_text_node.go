@@ -0,0 +1,183 @@
+package custom
+
+import (
+	"fmt"
+
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine/nodes"
+	"github.com/wdevore/RangerGo/engine/rendering"
+)
+
+// ttfPlacement is a glyph positioned in node-space by the layout.
+type ttfPlacement struct {
+	char rune
+	x, y float64
+}
+
+// TTFTextNode renders text using a TrueType/OpenType font.
+// The text is laid out in node-space where one unit equals one font pixel,
+// so the node's scale controls the final size. Rotation and scale
+// are honored, including mirroring by a negative scale, because each glyph
+// is rendered through the context's transform.
+type TTFTextNode struct {
+	nodes.Node
+
+	font api.ITrueTypeFont
+
+	text      string
+	textColor api.IPalette
+
+	alignment int
+	// Lines longer than wrapWidth are wrapped at word boundaries.
+	// A value <= 0 disables wrapping.
+	wrapWidth float64
+
+	placements []ttfPlacement
+	width      float64
+	height     float64
+	laidOut    bool
+}
+
+// NewTTFTextNode constructs a TrueType text node
+func NewTTFTextNode(name string, world api.IWorld, parent api.INode) api.INode {
+	o := new(TTFTextNode)
+	o.Initialize(name)
+	o.SetParent(parent)
+	parent.AddChild(o)
+	o.Build(world)
+	return o
+}
+
+// Build configures the node
+func (t *TTFTextNode) Build(world api.IWorld) {
+	t.Node.Build(world)
+
+	t.textColor = rendering.NewPaletteInt64(rendering.White)
+	t.alignment = api.TextAlignLeft
+}
+
+// SetFont sets the font used for rendering. Fonts can be shared
+// between nodes which also shares the glyph cache.
+func (t *TTFTextNode) SetFont(font api.ITrueTypeFont) {
+	t.font = font
+	t.laidOut = false
+}
+
+// SetText sets the text of node
+func (t *TTFTextNode) SetText(text string) {
+	t.text = text
+	t.laidOut = false
+}
+
+// SetColor sets the text color
+func (t *TTFTextNode) SetColor(color api.IPalette) {
+	t.textColor = color
+}
+
+// Color returns the node's color
+func (t *TTFTextNode) Color() api.IPalette {
+	return t.textColor
+}
+
+// SetAlignment sets the horizontal alignment, for example, api.TextAlignCenter
+func (t *TTFTextNode) SetAlignment(alignment int) {
+	t.alignment = alignment
+	t.laidOut = false
+}
+
+// SetWrapWidth sets the maximum line width in node-space.
+func (t *TTFTextNode) SetWrapWidth(width float64) {
+	t.wrapWidth = width
+	t.laidOut = false
+}
+
+// Dimensions returns the width and height of the laid out text.
+// The values are only valid after the node has been drawn at least once.
+func (t *TTFTextNode) Dimensions() (w, h float64) {
+	return t.width, t.height
+}
+
+// Layout positions each glyph based on alignment, wrapping and kerning.
+func (t *TTFTextNode) Layout() {
+	t.placements = t.placements[:0]
+	t.width = 0.0
+	t.height = 0.0
+	t.laidOut = true
+
+	if t.font == nil {
+		return
+	}
+
+	lines := wrapText(t.text, t.wrapWidth, t.measure)
+	lineSkip := float64(t.font.LineSkip())
+
+	y := 0.0
+	for _, line := range lines {
+		lineWidth := t.measure(line)
+
+		x := 0.0
+		switch t.alignment {
+		case api.TextAlignCenter:
+			x = -lineWidth / 2.0
+		case api.TextAlignRight:
+			x = -lineWidth
+		}
+
+		prev := rune(0)
+		for _, c := range line {
+			if prev != 0 {
+				x += float64(t.font.Kerning(prev, c))
+			}
+			t.placements = append(t.placements, ttfPlacement{char: c, x: x, y: y})
+			x += float64(t.font.Advance(c))
+			prev = c
+		}
+
+		if lineWidth > t.width {
+			t.width = lineWidth
+		}
+		y += lineSkip
+	}
+
+	t.height = y
+}
+
+// measure returns the width of a single line including kerning.
+func (t *TTFTextNode) measure(line string) float64 {
+	w := 0.0
+	prev := rune(0)
+	for _, c := range line {
+		if prev != 0 {
+			w += float64(t.font.Kerning(prev, c))
+		}
+		w += float64(t.font.Advance(c))
+		prev = c
+	}
+	return w
+}
+
+// Draw renders the glyphs
+func (t *TTFTextNode) Draw(context api.IRenderContext) {
+	if t.font == nil {
+		return
+	}
+
+	if !t.laidOut {
+		t.Layout()
+	}
+
+	context.SetDrawColor(t.textColor)
+
+	for _, p := range t.placements {
+		if p.char == ' ' {
+			continue
+		}
+
+		texture, w, h := t.font.Glyph(p.char)
+		context.RenderTexture(texture, p.x, p.y, float64(w), float64(h))
+	}
+}
+
+func (t TTFTextNode) String() string {
+	return fmt.Sprintf("%s = '%s'", t.Node, t.text)
+}
@@ -9,7 +9,13 @@ import (
 	"github.com/wdevore/RangerGo/engine/rendering"
 )
 
-// VectorTextNode is a node that render text using vector fonts
+// Vector glyphs are drawn in a unit wide cell centered on their origin.
+const unitGlyphWidth = 1.0
+
+// VectorTextNode is a node that render text using vector fonts.
+// Text is laid out in glyph-space where each line's baseline is
+// "VerticalOffset" below the previous line. The node's origin is the
+// first line's baseline at the position dictated by the alignment.
 type VectorTextNode struct {
 	nodes.Node
 
@@ -18,7 +24,16 @@ type VectorTextNode struct {
 	text      string
 	textColor api.IPalette
 
-	mesh *geometry.Mesh
+	alignment int
+	// Proportional uses each glyph's advance width rather than the
+	// font's fixed horizontal offset.
+	proportional bool
+	// Lines wider than maxWidth are wrapped at word boundaries.
+	// A value <= 0 disables wrapping.
+	maxWidth float64
+
+	mesh   *geometry.Mesh
+	bounds api.IRectangle
 }
 
 // NewVectorTextNode constructs a text node
@@ -29,7 +44,9 @@ func NewVectorTextNode(world api.IWorld, parent api.INode) *VectorTextNode {
 
 	o.textColor = rendering.NewPaletteInt64(rendering.White)
 	o.mesh = geometry.NewMesh()
+	o.bounds = geometry.NewRectangle()
 	o.world = world
+	o.alignment = api.TextAlignLeft
 	return o
 }
 
@@ -39,23 +56,148 @@ func (v *VectorTextNode) SetText(text string) {
 	v.SetDirty(true)
 }
 
+// SetColor sets the text color
+func (v *VectorTextNode) SetColor(color api.IPalette) {
+	v.textColor = color
+}
+
+// Color returns the node's color
+func (v *VectorTextNode) Color() api.IPalette {
+	return v.textColor
+}
+
+// SetAlignment sets the horizontal alignment, for example, api.TextAlignCenter
+func (v *VectorTextNode) SetAlignment(alignment int) {
+	v.alignment = alignment
+	v.SetDirty(true)
+}
+
+// SetProportional enables per-glyph advance widths and kerning.
+func (v *VectorTextNode) SetProportional(proportional bool) {
+	v.proportional = proportional
+	v.SetDirty(true)
+}
+
+// SetMaxWidth sets the maximum line width in glyph-space.
+func (v *VectorTextNode) SetMaxWidth(width float64) {
+	v.maxWidth = width
+	v.SetDirty(true)
+}
+
+// Bounds returns the local-space rectangle that encloses the text.
+// The bounds are only valid after the node has been rebuilt.
+func (v *VectorTextNode) Bounds() api.IRectangle {
+	return v.bounds
+}
+
+// PointInside checks if a local-space point is within the text bounds.
+// Use nodes.MapDeviceToNode to map a mouse position to local-space.
+func (v *VectorTextNode) PointInside(p api.IPoint) bool {
+	return v.bounds.ContainsPoint(p)
+}
+
 // ReBuild reconstructs the internal mesh based on text
 func (v *VectorTextNode) ReBuild() {
-	// Use glyph properties to adjust char location.
-	xpos := 0.0
+	v.mesh.Clear()
 
 	font := v.world.VectorFont()
-	for _, c := range v.text {
-		vertices := font.Glyph(byte(c))
+	ypos := 0.0
+
+	measure := func(line string) float64 { return v.measure(font, line) }
+
+	for _, line := range wrapText(v.text, v.maxWidth, measure) {
+		// Use glyph properties to adjust char location.
+		xpos := 0.0
+		switch v.alignment {
+		case api.TextAlignCenter:
+			xpos = -v.measure(font, line) / 2.0
+		case api.TextAlignRight:
+			xpos = -v.measure(font, line)
+		}
 
-		for i := 0; i < len(vertices); i += 2 {
-			v.mesh.AddVertex(vertices[i]+xpos, vertices[i+1])
+		if !v.proportional && v.alignment != api.TextAlignLeft {
+			// Fixed glyphs are centered on xpos rather than starting at it.
+			xpos += unitGlyphWidth / 2.0
 		}
 
-		xpos += font.HorizontalOffset()
+		prev := rune(0)
+		for _, c := range line {
+			offset := 0.0
+			if v.proportional {
+				if prev != 0 {
+					xpos += font.Kerning(prev, c)
+				}
+				// Glyphs are centered about 0 so shift the glyph's left
+				// edge onto the current position.
+				minX, _ := font.Extents(c)
+				offset = -minX
+			}
+
+			vertices := font.Glyph(c)
+
+			for i := 0; i < len(vertices); i += 2 {
+				v.mesh.AddVertex(vertices[i]+xpos+offset, vertices[i+1]+ypos)
+			}
+
+			xpos += v.advance(font, c)
+			prev = c
+		}
+
+		ypos += font.VerticalOffset()
 	}
 
 	v.mesh.Build()
+
+	if len(v.mesh.Vertices()) > 0 {
+		v.bounds.SetBounds(v.mesh.Vertices())
+	} else {
+		v.bounds.Set(0.0, 0.0, 0.0, 0.0)
+	}
+}
+
+// Measure returns the ink width of a single line in glyph-space using
+// the node's current settings.
+func (v *VectorTextNode) Measure(line string) float64 {
+	return v.measure(v.world.VectorFont(), line)
+}
+
+func (v *VectorTextNode) advance(font api.IVectorFont, c rune) float64 {
+	if v.proportional {
+		return font.Advance(c)
+	}
+
+	return font.HorizontalOffset()
+}
+
+func (v *VectorTextNode) width(font api.IVectorFont, c rune) float64 {
+	if v.proportional {
+		minX, maxX := font.Extents(c)
+		return maxX - minX
+	}
+
+	return unitGlyphWidth
+}
+
+// measure returns the ink width of a single line in glyph-space, which
+// is the advance of every glyph but the last plus the last one's width.
+func (v *VectorTextNode) measure(font api.IVectorFont, line string) float64 {
+	w := 0.0
+	prev := rune(0)
+	for _, c := range line {
+		if prev != 0 {
+			w += v.advance(font, prev)
+			if v.proportional {
+				w += font.Kerning(prev, c)
+			}
+		}
+		prev = c
+	}
+
+	if prev != 0 {
+		w += v.width(font, prev)
+	}
+
+	return w
 }
 
 // Draw renders shape
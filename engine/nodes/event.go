@@ -17,6 +17,7 @@ type Event struct {
 	eKeyModif    uint32
 	mx, my       int32
 	mxRel, myRel int32
+	eText        string
 	handled      bool
 }
 
@@ -41,6 +42,7 @@ func (e *Event) Reset() {
 	e.eKeyModif = 0
 	e.mx = 0
 	e.my = 0
+	e.eText = ""
 	e.handled = false
 }
 
@@ -176,6 +178,16 @@ func (e *Event) GetKeyMotif() uint32 {
 	return e.eKeyModif
 }
 
+// SetText sets the text of a text input event
+func (e *Event) SetText(text string) {
+	e.eText = text
+}
+
+// GetText gets
+func (e *Event) GetText() string {
+	return e.eText
+}
+
 func (e Event) String() string {
 	s := "----------Event---------\n"
 	s += fmt.Sprintf("mx: %d, my: %d\n", e.mx, e.my)
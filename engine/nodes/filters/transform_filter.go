@@ -44,6 +44,8 @@ func (t *TransformFilter) Visit(context api.IRenderContext, interpolation float6
 
 	context.Save()
 
+	nodes.ApplyBlending(t, context)
+
 	children := t.Children()
 
 	for _, child := range children {
@@ -41,6 +41,8 @@ func (t *TranslateFilter) Visit(context api.IRenderContext, interpolation float6
 
 	context.Save()
 
+	nodes.ApplyBlending(t, context)
+
 	children := t.Children()
 
 	for _, child := range children {
@@ -1,10 +1,16 @@
 package nodes
 
-import "github.com/wdevore/RangerGo/api"
+import (
+	"sort"
+
+	"github.com/wdevore/RangerGo/api"
+)
 
 // Group holds the children properties and methods.
 type Group struct {
 	children []api.INode
+	// Added children are sorted when the children are next needed.
+	unsorted bool
 }
 
 func (g *Group) initializeGroup() {
@@ -14,6 +20,9 @@ func (g *Group) initializeGroup() {
 // Children returns the children of current node.
 // Nodes should override this method for providing any child they contain.
 func (g *Group) Children() []api.INode {
+	if g.unsorted {
+		g.SortChildren()
+	}
 	return g.children
 }
 
@@ -21,5 +30,141 @@ func (g *Group) Children() []api.INode {
 func (g *Group) AddChild(child api.INode) {
 	if child != nil {
 		g.children = append(g.children, child)
+		g.unsorted = true
+	}
+}
+
+// RemoveChild removes a child and clears its parent. Use Detach to
+// remove a child from a running scene.
+func (g *Group) RemoveChild(child api.INode) bool {
+	if !g.detach(child) {
+		return false
+	}
+
+	child.SetParent(nil)
+	return true
+}
+
+// InsertAt inserts a child at an index, clamped to the children. The
+// caller sets the child's parent, as with AddChild. Use AttachAt to
+// insert into a running scene.
+func (g *Group) InsertAt(index int, child api.INode) {
+	if child == nil {
+		return
+	}
+
+	if index < 0 {
+		index = 0
+	} else if index >= len(g.children) {
+		g.AddChild(child)
+		return
+	}
+
+	// The index is into the sorted children.
+	children := g.Children()
+	g.children = append(children, nil)
+	copy(g.children[index+1:], g.children[index:])
+	g.children[index] = child
+	g.unsorted = true
+}
+
+// IndexOf returns a child's index or -1
+func (g *Group) IndexOf(child api.INode) int {
+	for i, c := range g.Children() {
+		if c == child {
+			return i
+		}
+	}
+	return -1
+}
+
+// FindByName returns the first child with the name or nil
+func (g *Group) FindByName(name string) api.INode {
+	for _, c := range g.Children() {
+		if c.Name() == name {
+			return c
+		}
+	}
+	return nil
+}
+
+// FindByID returns the child with the id or nil
+func (g *Group) FindByID(id int) api.INode {
+	for _, c := range g.children {
+		if c.ID() == id {
+			return c
+		}
+	}
+	return nil
+}
+
+// BringToFront moves a child above its siblings in the same draw layer.
+// The child's z-index is raised if a sibling's is higher.
+func (g *Group) BringToFront(child api.INode) {
+	if !g.detach(child) {
+		return
+	}
+
+	z := child.ZIndex()
+	for _, sibling := range g.children {
+		if sibling.DrawLayer() == child.DrawLayer() && sibling.ZIndex() > z {
+			z = sibling.ZIndex()
+		}
+	}
+
+	// Last among equals is top most.
+	g.children = append(g.children, child)
+	g.reorder(child, z)
+}
+
+// SendToBack moves a child below its siblings in the same draw layer.
+// The child's z-index is lowered if a sibling's is lower.
+func (g *Group) SendToBack(child api.INode) {
+	if !g.detach(child) {
+		return
+	}
+
+	z := child.ZIndex()
+	for _, sibling := range g.children {
+		if sibling.DrawLayer() == child.DrawLayer() && sibling.ZIndex() < z {
+			z = sibling.ZIndex()
+		}
+	}
+
+	// First among equals is bottom most.
+	g.children = append([]api.INode{child}, g.children...)
+	g.reorder(child, z)
+}
+
+// SortChildren orders the children by draw layer and then z-index.
+// The sort is stable so equal children keep their order.
+func (g *Group) SortChildren() {
+	sort.SliceStable(g.children, func(i, j int) bool {
+		a, b := g.children[i], g.children[j]
+		if a.DrawLayer() != b.DrawLayer() {
+			return a.DrawLayer() < b.DrawLayer()
+		}
+		return a.ZIndex() < b.ZIndex()
+	})
+	g.unsorted = false
+}
+
+// detach removes a child while keeping the order of the others.
+func (g *Group) detach(child api.INode) bool {
+	i := g.IndexOf(child)
+	if i < 0 {
+		return false
+	}
+
+	g.children = append(g.children[:i], g.children[i+1:]...)
+	return true
+}
+
+func (g *Group) reorder(child api.INode, z int) {
+	if z != child.ZIndex() {
+		// SetZIndex sorts via the parent.
+		child.SetZIndex(z)
+	} else {
+		g.SortChildren()
 	}
 }
@@ -0,0 +1,86 @@
+package nodes
+
+import "github.com/wdevore/RangerGo/api"
+
+// staged is implemented by Node so the manager can track which nodes
+// are part of the running scene.
+type staged interface {
+	setStage(manager api.INodeManager)
+	onStage() api.INodeManager
+}
+
+// animated is implemented by Node so the manager can update the
+// motions bound to a node.
+type animated interface {
+	motionNode() *Node
+}
+
+// boundMotion pairs a motion with the setter receiving its values.
+type boundMotion struct {
+	motion api.IMotion
+	apply  func(value interface{})
+}
+
+// lifecycles is implemented by the node manager.
+type lifecycles interface {
+	enterNodes(node api.INode)
+	exitNodes(node api.INode)
+
+	trackMotions(node *Node)
+	untrackMotions(node *Node)
+}
+
+// stageOf returns the manager running the node's scene, or nil.
+func stageOf(node api.INode) api.INodeManager {
+	if s, ok := node.(staged); ok {
+		return s.onStage()
+	}
+	return nil
+}
+
+// Attach adds a child to a parent. See AttachAt.
+func Attach(parent, child api.INode) {
+	AttachAt(parent, child, len(parent.Children()))
+}
+
+// AttachAt inserts a child at an index among the parent's children. Any
+// current parent is detached first. The child's transforms are marked
+// dirty and, if the parent is part of a running scene, EnterNode is
+// called on the child's subtree.
+func AttachAt(parent, child api.INode, index int) {
+	previous := stageOf(child)
+
+	if child.HasParent() {
+		child.Parent().RemoveChild(child)
+	}
+
+	child.SetParent(parent)
+	parent.InsertAt(index, child)
+	child.RippleDirty(true)
+
+	// Moving within the same scene doesn't exit or enter.
+	stage := stageOf(parent)
+	if previous == stage {
+		return
+	}
+
+	if m, ok := previous.(lifecycles); ok {
+		m.exitNodes(child)
+	}
+
+	if m, ok := stage.(lifecycles); ok {
+		m.enterNodes(child)
+	}
+}
+
+// Detach removes a node from its parent. If the node is part of a
+// running scene ExitNode is called on its subtree.
+func Detach(child api.INode) {
+	if child.HasParent() {
+		child.Parent().RemoveChild(child)
+	}
+
+	if m, ok := stageOf(child).(lifecycles); ok {
+		m.exitNodes(child)
+	}
+}
@@ -8,14 +8,28 @@ import (
 	"github.com/wdevore/RangerGo/engine/geometry"
 )
 
-var ids = 0
+// unassignedID marks a node whose id is allocated by Build
+const unassignedID = -1
 
 // Node is an embedded type used by all nodes.
 type Node struct {
-	id      int
-	name    string
-	visible bool
-	dirty   bool
+	id       int
+	assigned bool // id was allocated or set, not the zero value
+	name     string
+	tags     []string
+	visible  bool
+	dirty    bool
+
+	opacity float64
+	blend   int
+
+	zIndex    int
+	drawLayer int
+
+	// stage is the manager running this node's scene, otherwise nil.
+	stage api.INodeManager
+
+	motions []boundMotion
 
 	parent api.INode
 	world  api.IWorld
@@ -36,18 +50,85 @@ func (n *Node) ID() int {
 	return n.id
 }
 
-// SetID overrides the autogenerated Id
+// SetID overrides the autogenerated Id. If the node is in the running
+// scene its registration moves to the new id, unless another node is
+// registered with it.
 func (n *Node) SetID(id int) {
+	if n.stage != nil {
+		registry := n.world.NodeRegistry()
+		if node := registry.Lookup(n.id); node != nil {
+			registry.Unregister(node)
+			old := n.id
+			n.id = id
+			if !registry.Register(node) {
+				n.id = old
+				registry.Register(node)
+				return
+			}
+			n.assigned = true
+			registry.Reserve(id)
+			return
+		}
+	}
+
 	n.id = id
+	n.assigned = true
+	n.reserve()
 }
 
-// Initialize called by base objects from their Initialize
+// reserve keeps the world's registry from allocating the node's id to
+// another node. Nodes without a world reserve their id when built.
+func (n *Node) reserve() {
+	if n.world != nil {
+		n.world.NodeRegistry().Reserve(n.id)
+	}
+}
+
+// AddTag adds a tag unless the node already has it
+func (n *Node) AddTag(tag string) {
+	if !n.HasTag(tag) {
+		n.tags = append(n.tags, tag)
+	}
+}
+
+// RemoveTag removes a tag
+func (n *Node) RemoveTag(tag string) {
+	for i, t := range n.tags {
+		if t == tag {
+			n.tags = append(n.tags[:i], n.tags[i+1:]...)
+			return
+		}
+	}
+}
+
+// HasTag indicates if the node has a tag
+func (n *Node) HasTag(tag string) bool {
+	for _, t := range n.tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// Tags returns the node's tags
+func (n *Node) Tags() []string {
+	return n.tags
+}
+
+// Initialize called by base objects from their Initialize. The node's
+// id is allocated from the world's registry by Build, and a node built
+// before it was initialized keeps the id it was given.
 func (n *Node) Initialize(name string) {
-	n.id = ids
-	ids++
+	if !n.assigned {
+		n.id = unassignedID
+	}
 	n.name = name
 	n.visible = true
 	n.dirty = true
+	n.opacity = 1.0
+	n.blend = api.BlendInherit
+	n.drawLayer = api.DrawLayerWorld
 
 	n.initializeTransform()
 	n.initializeGroup()
@@ -56,6 +137,13 @@ func (n *Node) Initialize(name string) {
 // Build builds this nodes internal geometry
 func (n *Node) Build(world api.IWorld) {
 	n.world = world
+
+	if !n.assigned {
+		n.id = world.NodeRegistry().NextID()
+		n.assigned = true
+	} else {
+		n.reserve()
+	}
 }
 
 // World returns cached world object
@@ -66,9 +154,14 @@ func (n *Node) World() api.IWorld {
 // InitializeWithID called by base objects from their Initialize
 func (n *Node) InitializeWithID(id int, name string) {
 	n.id = id
+	n.assigned = true
+	n.reserve()
 	n.name = name
 	n.visible = true
 	n.dirty = true
+	n.opacity = 1.0
+	n.blend = api.BlendInherit
+	n.drawLayer = api.DrawLayerWorld
 }
 
 // Visit traverses "down" the heirarchy while space-mappings traverses upward.
@@ -80,6 +173,8 @@ func Visit(node api.INode, context api.IRenderContext, interpolation float64) {
 
 	context.Save()
 
+	ApplyBlending(node, context)
+
 	// Because position and angles are dependent
 	// on lerping we perform interpolation first.
 	node.Interpolate(interpolation)
@@ -99,9 +194,9 @@ func Visit(node api.INode, context api.IRenderContext, interpolation float64) {
 
 	if len(children) > 0 {
 		for _, child := range children {
-			filter, isFilterType := child.(api.IFilter)
-			if isFilterType {
-				filter.Visit(context, interpolation)
+			visitor, isVisitorType := child.(api.IVisitor)
+			if isVisitorType {
+				visitor.Visit(context, interpolation)
 			} else {
 				Visit(child, context, interpolation)
 			}
@@ -111,6 +206,16 @@ func Visit(node api.INode, context api.IRenderContext, interpolation float64) {
 	context.Restore()
 }
 
+// ApplyBlending cascades the node's opacity and blend mode into the
+// context. Custom visitors call it after saving the context.
+func ApplyBlending(node api.INode, context api.IRenderContext) {
+	context.SetOpacity(context.Opacity() * node.Opacity())
+
+	if mode := node.BlendMode(); mode != api.BlendInherit {
+		context.SetBlendMode(mode)
+	}
+}
+
 // SetParent binds upward parent.
 func (n *Node) SetParent(parent api.INode) {
 	n.parent = parent
@@ -136,9 +241,101 @@ func (n *Node) SetVisible(visible bool) {
 	n.visible = visible
 }
 
-// Interpolate is used for blending time based properties.
+// Interpolate is used for blending time based properties. It applies
+// any bound motions; nodes overriding it should call it as well.
 func (n *Node) Interpolate(interpolation float64) {
-	// fmt.Println("Node Interpolate on: ", n)
+	for _, b := range n.motions {
+		b.apply(b.motion.Interpolate(interpolation))
+	}
+}
+
+// AddMotion binds a motion whose interpolated values are passed to apply.
+func (n *Node) AddMotion(motion api.IMotion, apply func(value interface{})) {
+	n.motions = append(n.motions, boundMotion{motion: motion, apply: apply})
+
+	if m, ok := n.stage.(lifecycles); ok && len(n.motions) == 1 {
+		m.trackMotions(n)
+	}
+}
+
+// RemoveMotion unbinds a motion.
+func (n *Node) RemoveMotion(motion api.IMotion) {
+	for i, b := range n.motions {
+		if b.motion == motion {
+			n.motions = append(n.motions[:i:i], n.motions[i+1:]...)
+			break
+		}
+	}
+
+	if m, ok := n.stage.(lifecycles); ok && len(n.motions) == 0 {
+		m.untrackMotions(n)
+	}
+}
+
+func (n *Node) motionNode() *Node {
+	return n
+}
+
+func (n *Node) updateMotions(dt float64) {
+	for _, b := range n.motions {
+		b.motion.Update(dt)
+	}
+}
+
+// SetOpacity sets the node's opacity (0.0 -> 1.0)
+func (n *Node) SetOpacity(opacity float64) {
+	n.opacity = opacity
+}
+
+// Opacity returns the node's own, not cascaded, opacity
+func (n *Node) Opacity() float64 {
+	return n.opacity
+}
+
+// SetBlendMode sets the blend mode for this node and its children
+func (n *Node) SetBlendMode(mode int) {
+	n.blend = mode
+}
+
+// BlendMode returns the node's blend mode
+func (n *Node) BlendMode() int {
+	return n.blend
+}
+
+// SetZIndex orders the node among its siblings
+func (n *Node) SetZIndex(z int) {
+	n.zIndex = z
+	n.sortSiblings()
+}
+
+// ZIndex returns the node's z-index
+func (n *Node) ZIndex() int {
+	return n.zIndex
+}
+
+// SetDrawLayer places the node in a draw layer, for example, api.DrawLayerHUD
+func (n *Node) SetDrawLayer(layer int) {
+	n.drawLayer = layer
+	n.sortSiblings()
+}
+
+// DrawLayer returns the node's draw layer
+func (n *Node) DrawLayer() int {
+	return n.drawLayer
+}
+
+func (n *Node) setStage(manager api.INodeManager) {
+	n.stage = manager
+}
+
+func (n *Node) onStage() api.INodeManager {
+	return n.stage
+}
+
+func (n *Node) sortSiblings() {
+	if n.parent != nil {
+		n.parent.SortChildren()
+	}
 }
 
 // IsDirty indicates if the node has been modified.
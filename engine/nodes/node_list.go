@@ -6,9 +6,16 @@ import (
 	"github.com/wdevore/RangerGo/api"
 )
 
-// NodeList is a simple list collection
+// NodeList is a simple list collection. Changes made while the list is
+// locked, for example, a node unregistering itself from inside a
+// handler, are deferred until it is unlocked.
 type NodeList struct {
 	items []api.INode
+
+	locks   int
+	pending []api.INode
+	// removed indicates nil entries need compacting
+	removed bool
 }
 
 // NewNodeList create a new list collection
@@ -22,37 +29,93 @@ func (l *NodeList) Items() []api.INode {
 	return l.items
 }
 
-// Add adds item
+// Add adds item unless it is already present
 func (l *NodeList) Add(node api.INode) {
-	l.items = append(l.items, node)
+	if node == nil || l.FindFirstElement(node) >= 0 || l.pendingIndex(node) >= 0 {
+		return
+	}
+
+	if l.locks > 0 {
+		l.pending = append(l.pending, node)
+	} else {
+		l.items = append(l.items, node)
+	}
 }
 
 // Remove removes item
 func (l *NodeList) Remove(node api.INode) {
-	idx := l.FindFirstElement(node, l.items)
+	if idx := l.pendingIndex(node); idx >= 0 {
+		l.pending = append(l.pending[:idx], l.pending[idx+1:]...)
+		return
+	}
 
-	if idx >= 0 {
-		l.DeleteAt(idx, l.items)
-	} else {
+	idx := l.FindFirstElement(node)
+
+	if idx < 0 {
 		fmt.Println("NodeManager: Unable to remove ", node, " node")
+		return
+	}
+
+	if l.locks > 0 {
+		l.items[idx] = nil
+		l.removed = true
+	} else {
+		l.DeleteAt(idx)
 	}
 }
 
-// DeleteAt removes an item from the slice
-func (l *NodeList) DeleteAt(i int, slice []api.INode) {
-	// Remove the element at index i from slice.
-	copy(slice[i:], slice[i+1:]) // Shift a[i+1:] left one index.
-	slice[len(slice)-1] = nil    // Erase last element (write zero value).
-	slice = slice[:len(slice)-1] // Truncate slice.
+// DeleteAt removes an item from the list
+func (l *NodeList) DeleteAt(i int) {
+	l.items = DeleteAt(i, l.items)
 }
 
-// FindFirstElement finds the first item in the slice
-func (l *NodeList) FindFirstElement(node api.INode, slice []api.INode) int {
-	for idx, item := range slice {
-		if item.ID() == node.ID() {
-			return idx
+// FindFirstElement finds the first item in the list
+func (l *NodeList) FindFirstElement(node api.INode) int {
+	return FindFirstElement(node, l.items)
+}
+
+// Lock defers changes until the matching Unlock
+func (l *NodeList) Lock() {
+	l.locks++
+}
+
+// Unlock applies the deferred changes once the last lock is released
+func (l *NodeList) Unlock() {
+	if l.locks == 0 {
+		return
+	}
+
+	l.locks--
+	if l.locks > 0 {
+		return
+	}
+
+	if l.removed {
+		items := l.items[:0]
+		for _, item := range l.items {
+			if item != nil {
+				items = append(items, item)
+			}
+		}
+
+		// Clear the tail so removed nodes can be collected.
+		for i := len(items); i < len(l.items); i++ {
+			l.items[i] = nil
 		}
+
+		l.items = items
+		l.removed = false
 	}
 
+	l.items = append(l.items, l.pending...)
+	l.pending = l.pending[:0]
+}
+
+func (l *NodeList) pendingIndex(node api.INode) int {
+	for idx, item := range l.pending {
+		if item == node {
+			return idx
+		}
+	}
 	return -1
 }
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine/animation/actions"
 )
 
 type nodeManager struct {
@@ -16,6 +17,11 @@ type nodeManager struct {
 
 	timingTargets api.INodeList
 	eventTargets  api.INodeList
+
+	actions api.IActionRunner
+
+	// Nodes with bound motions
+	animated []*Node
 }
 
 // NewNodeManager constructs a manager for node.
@@ -34,6 +40,8 @@ func NewNodeManager(world api.IWorld) api.INodeManager {
 	o.timingTargets = NewNodeList()
 	o.eventTargets = NewNodeList()
 
+	o.actions = actions.NewRunner()
+
 	return o
 }
 
@@ -117,8 +125,21 @@ func (m *nodeManager) ReplaceNode(node api.INode) {
 // --------------------------------------------------------------------------
 
 func (m *nodeManager) Update(msPerUpdate, secPerUpdate float64) {
+	m.actions.Update(msPerUpdate)
+
+	// Tracking replaces the slice so it can change while updating.
+	for _, node := range m.animated {
+		node.updateMotions(msPerUpdate)
+	}
+
+	// Targets can register and unregister while updating.
+	m.timingTargets.Lock()
+	defer m.timingTargets.Unlock()
+
 	for _, target := range m.timingTargets.Items() {
-		target.Update(msPerUpdate, secPerUpdate)
+		if target != nil {
+			target.Update(msPerUpdate, secPerUpdate)
+		}
 	}
 }
 
@@ -130,6 +151,28 @@ func (m *nodeManager) UnRegisterTarget(target api.INode) {
 	m.timingTargets.Remove(target)
 }
 
+func (m *nodeManager) Actions() api.IActionRunner {
+	return m.actions
+}
+
+func (m *nodeManager) trackMotions(node *Node) {
+	for _, n := range m.animated {
+		if n == node {
+			return
+		}
+	}
+	m.animated = append(m.animated[:len(m.animated):len(m.animated)], node)
+}
+
+func (m *nodeManager) untrackMotions(node *Node) {
+	for i, n := range m.animated {
+		if n == node {
+			m.animated = append(m.animated[:i:i], m.animated[i+1:]...)
+			return
+		}
+	}
+}
+
 // --------------------------------------------------------------------------
 // IO events
 // --------------------------------------------------------------------------
@@ -147,7 +190,15 @@ func (m *nodeManager) RouteEvents(event api.IEvent) {
 		return
 	}
 
+	// Targets can register and unregister while handling.
+	m.eventTargets.Lock()
+	defer m.eventTargets.Unlock()
+
 	for _, target := range m.eventTargets.Items() {
+		if target == nil {
+			continue
+		}
+
 		handled := target.Handle(event)
 
 		if handled {
@@ -189,6 +240,34 @@ func (m *nodeManager) End() {
 
 func (m *nodeManager) enterNodes(node api.INode) {
 	// fmt.Println("NodeManager: enter-node ", node)
+	s, isStaged := node.(staged)
+	// Children attached during an EnterNode have already entered.
+	if isStaged && s.onStage() == api.INodeManager(m) {
+		return
+	}
+
+	// Register before staging so SetID doesn't move another node's
+	// registration.
+	registry := m.world.NodeRegistry()
+	if node.ID() == unassignedID {
+		// The node was never built.
+		node.SetID(registry.NextID())
+	}
+	if !registry.Register(node) {
+		// Another node has the id so this one gets a fresh id.
+		node.SetID(registry.NextID())
+		fmt.Println("NodeManager: reassigned ", node, " a new id")
+		registry.Register(node)
+	}
+
+	if isStaged {
+		s.setStage(m)
+	}
+
+	if a, ok := node.(animated); ok && len(a.motionNode().motions) > 0 {
+		m.trackMotions(a.motionNode())
+	}
+
 	node.EnterNode(m)
 
 	children := node.Children()
@@ -199,6 +278,21 @@ func (m *nodeManager) enterNodes(node api.INode) {
 
 func (m *nodeManager) exitNodes(node api.INode) {
 	// fmt.Println("NodeManager: exit-node ", node)
+	s, isStaged := node.(staged)
+	if isStaged {
+		if s.onStage() == nil {
+			return
+		}
+		s.setStage(nil)
+	}
+
+	m.world.NodeRegistry().Unregister(node)
+	m.actions.Stop(node)
+
+	if a, ok := node.(animated); ok {
+		m.untrackMotions(a.motionNode())
+	}
+
 	node.ExitNode(m)
 
 	children := node.Children()
@@ -214,18 +308,19 @@ func (m nodeManager) String() string {
 	return fmt.Sprintf("%s", m.stack)
 }
 
-// DeleteAt removes an item from the slice
-func DeleteAt(i int, slice []api.INode) {
+// DeleteAt removes an item from the slice and returns the shortened slice
+func DeleteAt(i int, slice []api.INode) []api.INode {
 	// Remove the element at index i from slice.
 	copy(slice[i:], slice[i+1:]) // Shift a[i+1:] left one index.
 	slice[len(slice)-1] = nil    // Erase last element (write zero value).
-	slice = slice[:len(slice)-1] // Truncate slice.
+	return slice[:len(slice)-1]  // Truncate slice.
 }
 
-// FindFirstElement finds the first item in the slice
+// FindFirstElement finds the first item in the slice. Nodes are
+// compared by identity because IDs can be overridden.
 func FindFirstElement(node api.INode, slice []api.INode) int {
 	for idx, item := range slice {
-		if item.ID() == node.ID() {
+		if item == node {
 			return idx
 		}
 	}
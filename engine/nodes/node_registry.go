@@ -0,0 +1,77 @@
+package nodes
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/wdevore/RangerGo/api"
+)
+
+type nodeRegistry struct {
+	mutex sync.Mutex
+
+	next     int
+	nodes    map[int]api.INode
+	reserved map[int]bool
+}
+
+// NewNodeRegistry constructs a registry. Each world has one.
+func NewNodeRegistry() api.INodeRegistry {
+	o := new(nodeRegistry)
+	o.nodes = map[int]api.INode{}
+	o.reserved = map[int]bool{}
+	return o
+}
+
+func (r *nodeRegistry) NextID() int {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	// Skip ids that were assigned explicitly.
+	for {
+		id := r.next
+		r.next++
+
+		if _, taken := r.nodes[id]; !taken && !r.reserved[id] {
+			return id
+		}
+	}
+}
+
+func (r *nodeRegistry) Reserve(id int) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.reserved[id] = true
+}
+
+func (r *nodeRegistry) Register(node api.INode) bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	id := node.ID()
+
+	if existing, taken := r.nodes[id]; taken && existing != node {
+		fmt.Println("NodeRegistry: id ", id, " of ", node, " collides with ", existing)
+		return false
+	}
+
+	r.nodes[id] = node
+	return true
+}
+
+func (r *nodeRegistry) Unregister(node api.INode) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.nodes[node.ID()] == node {
+		delete(r.nodes, node.ID())
+	}
+}
+
+func (r *nodeRegistry) Lookup(id int) api.INode {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return r.nodes[id]
+}
@@ -0,0 +1,104 @@
+package nodes
+
+import (
+	"strings"
+
+	"github.com/wdevore/RangerGo/api"
+)
+
+// Walk visits the descendants of a node, depth first in child order.
+// Returning false from visit stops the walk.
+func Walk(node api.INode, visit func(node api.INode) bool) bool {
+	for _, child := range node.Children() {
+		if !visit(child) || !Walk(child, visit) {
+			return false
+		}
+	}
+	return true
+}
+
+// FindByName returns the first descendant with the name, or nil.
+func FindByName(root api.INode, name string) api.INode {
+	return FindFirst(root, func(node api.INode) bool {
+		return node.Name() == name
+	})
+}
+
+// FindByTag returns all descendants with the tag.
+func FindByTag(root api.INode, tag string) []api.INode {
+	return FindAll(root, func(node api.INode) bool {
+		return node.HasTag(tag)
+	})
+}
+
+// FindFirst returns the first descendant matching the predicate, or nil.
+func FindFirst(root api.INode, predicate func(node api.INode) bool) api.INode {
+	var found api.INode
+
+	Walk(root, func(node api.INode) bool {
+		if predicate(node) {
+			found = node
+			return false
+		}
+		return true
+	})
+
+	return found
+}
+
+// FindAll returns all descendants matching the predicate.
+func FindAll(root api.INode, predicate func(node api.INode) bool) []api.INode {
+	found := []api.INode{}
+
+	Walk(root, func(node api.INode) bool {
+		if predicate(node) {
+			found = append(found, node)
+		}
+		return true
+	})
+
+	return found
+}
+
+// FindByPath follows a slash separated path of child names from root,
+// for example, "Game Layer/Ship/Gun". ".." is the parent. A leading
+// slash starts at the top most ancestor, whose name is the first
+// element, for example, "/Splash/Game Layer". It returns nil if any
+// element isn't found.
+func FindByPath(root api.INode, path string) api.INode {
+	node := root
+
+	if strings.HasPrefix(path, "/") {
+		for node.HasParent() {
+			node = node.Parent()
+		}
+
+		path = strings.TrimPrefix(path, "/")
+		elements := strings.SplitN(path, "/", 2)
+		if elements[0] != node.Name() {
+			return nil
+		}
+
+		if len(elements) == 1 {
+			return node
+		}
+		path = elements[1]
+	}
+
+	for _, name := range strings.Split(path, "/") {
+		switch name {
+		case "", ".":
+			continue
+		case "..":
+			node = node.Parent()
+		default:
+			node = node.FindByName(name)
+		}
+
+		if node == nil {
+			return nil
+		}
+	}
+
+	return node
+}
@@ -22,8 +22,13 @@ func MapDeviceToNode(dvx, dvy int32, node api.INode, localPoint api.IPoint) {
 	// Mapping from device to node requires transforms from two "directions"
 	// 1st is upwards transform and the 2nd is downwards transform.
 
-	// downwards from device-space to view-space
-	MapDeviceToView(node.World(), dvx, dvy, tViewPoint)
+	// downwards from device-space to view-space, through a camera's
+	// viewport if the node is viewed by one.
+	if camera := cameraOf(node); camera != nil {
+		camera.MapDeviceToView(dvx, dvy, tViewPoint)
+	} else {
+		MapDeviceToView(node.World(), dvx, dvy, tViewPoint)
+	}
 
 	// Upwards from node to world-space (aka view-space)
 	wtn := WorldToNodeTransform(node, nil)
@@ -58,7 +63,23 @@ func MapNodeToWorld(node api.INode, viewPoint api.IPoint) {
 func MapNodeToDevice(world api.IWorld, node api.INode, viewPoint api.IPoint) {
 	ntw := NodeToWorldTransform(node, nil)
 	ntw.TransformCompToPoint(0.0, 0.0, viewPoint)
-	world.ViewSpace().TransformCompToPoint(viewPoint.X(), viewPoint.Y(), viewPoint)
+
+	if camera := cameraOf(node); camera != nil {
+		camera.MapViewToDevice(viewPoint, viewPoint)
+	} else {
+		world.ViewSpace().TransformCompToPoint(viewPoint.X(), viewPoint.Y(), viewPoint)
+	}
+}
+
+// cameraOf returns the nearest camera node at or above node, or nil.
+func cameraOf(node api.INode) api.ICameraNode {
+	for n := node; n != nil; n = n.Parent() {
+		if camera, isCamera := n.(api.ICameraNode); isCamera {
+			return camera
+		}
+	}
+
+	return nil
 }
 
 // WorldToNodeTransform maps a world-space coordinate to local-space of node
@@ -0,0 +1,36 @@
+package ui
+
+import (
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine/geometry"
+)
+
+// box is an axis aligned rectangle in widget-space along with
+// its transformed device-space corners.
+type box struct {
+	min, max api.IPoint
+	o1, o2   api.IPoint
+}
+
+func newBox() *box {
+	o := new(box)
+	o.min = geometry.NewPoint()
+	o.max = geometry.NewPoint()
+	o.o1 = geometry.NewPoint()
+	o.o2 = geometry.NewPoint()
+	return o
+}
+
+func (b *box) set(minX, minY, maxX, maxY float64) {
+	b.min.SetByComp(minX, minY)
+	b.max.SetByComp(maxX, maxY)
+}
+
+func (b *box) transform(context api.IRenderContext) {
+	context.TransformPoints(b.min, b.max, b.o1, b.o2)
+}
+
+func (b *box) render(context api.IRenderContext, color api.IPalette, fill int) {
+	context.SetDrawColor(color)
+	context.RenderAARectangle(b.o1, b.o2, fill)
+}
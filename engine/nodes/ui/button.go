@@ -0,0 +1,122 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine/nodes/custom"
+)
+
+// Button is a push button with a centered caption. The action is
+// called when the button is clicked or activated with Space/Enter.
+type Button struct {
+	Widget
+
+	text    string
+	caption *custom.VectorTextNode
+
+	pressed bool
+}
+
+// NewButton constructs a button widget
+func NewButton(name string, world api.IWorld, parent api.INode) api.INode {
+	o := new(Button)
+	o.Initialize(name)
+	o.SetParent(parent)
+	parent.AddChild(o)
+	o.Build(world)
+	return o
+}
+
+// Build configures the widget
+func (b *Button) Build(world api.IWorld) {
+	b.Widget.Build(world)
+
+	b.focusable = true
+	b.caption = newCaption(world, b)
+	b.SetSize(b.PreferredSize())
+}
+
+// SetText sets the button's caption and resizes the button to fit.
+func (b *Button) SetText(text string) {
+	b.text = text
+	b.caption.SetText(text)
+	b.SetSize(b.PreferredSize())
+}
+
+// Text returns the button's caption
+func (b *Button) Text() string {
+	return b.text
+}
+
+// PreferredSize fits the caption
+func (b *Button) PreferredSize() (w, h float64) {
+	theme := b.Theme()
+	return captionWidth(b.caption, b.text, theme.TextSize) + theme.Padding*4.0,
+		theme.TextSize + theme.Padding*2.0
+}
+
+// Click activates the button
+func (b *Button) Click() {
+	if b.enabled && b.action != nil {
+		b.action(b)
+	}
+}
+
+// Draw renders the button
+func (b *Button) Draw(context api.IRenderContext) {
+	theme := b.Theme()
+
+	if b.IsDirty() {
+		b.frame.transform(context)
+
+		tw := captionWidth(b.caption, b.text, theme.TextSize)
+		b.caption.SetScale(theme.TextSize)
+		b.caption.SetPosition((b.width-tw)/2.0, (b.height+theme.TextSize)/2.0)
+		b.SetDirty(false)
+	}
+
+	background := theme.Background
+	if b.pressed {
+		background = theme.Pressed
+	} else if b.hovered {
+		background = theme.Hover
+	}
+
+	b.frame.render(context, background, api.FILLED)
+	b.frame.render(context, b.borderColor(), api.OUTLINED)
+
+	b.caption.SetColor(b.textColor())
+}
+
+// Handle processes IO events routed by the root Panel
+func (b *Button) Handle(event api.IEvent) bool {
+	switch event.GetType() {
+	case api.IOTypeMouseMotion:
+		b.trackMouse(event)
+	case api.IOTypeMouseButtonDown:
+		if b.trackMouse(event) {
+			b.pressed = true
+			return true
+		}
+	case api.IOTypeMouseButtonUp:
+		if b.pressed {
+			b.pressed = false
+			if b.trackMouse(event) {
+				b.Click()
+			}
+			return true
+		}
+	case api.IOTypeKeyboard:
+		if isPressed(event) && isActivateKey(event) {
+			b.Click()
+			return true
+		}
+	}
+
+	return false
+}
+
+func (b Button) String() string {
+	return fmt.Sprintf("%s = '%s'", b.Node, b.text)
+}
@@ -0,0 +1,142 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine/nodes/custom"
+)
+
+// Checkbox is a toggle with a caption to the right of its box.
+// The action is called whenever the checked state changes.
+type Checkbox struct {
+	Widget
+
+	text    string
+	caption *custom.VectorTextNode
+
+	checked bool
+
+	check  *box
+	marker *box
+}
+
+// NewCheckbox constructs a checkbox widget
+func NewCheckbox(name string, world api.IWorld, parent api.INode) api.INode {
+	o := new(Checkbox)
+	o.Initialize(name)
+	o.SetParent(parent)
+	parent.AddChild(o)
+	o.Build(world)
+	return o
+}
+
+// Build configures the widget
+func (c *Checkbox) Build(world api.IWorld) {
+	c.Widget.Build(world)
+
+	c.focusable = true
+	c.check = newBox()
+	c.marker = newBox()
+	c.caption = newCaption(world, c)
+	c.SetSize(c.PreferredSize())
+}
+
+// SetText sets the checkbox's caption and resizes the checkbox to fit.
+func (c *Checkbox) SetText(text string) {
+	c.text = text
+	c.caption.SetText(text)
+	c.SetSize(c.PreferredSize())
+}
+
+// IsChecked returns the checked state
+func (c *Checkbox) IsChecked() bool {
+	return c.checked
+}
+
+// SetChecked sets the checked state without calling the action
+func (c *Checkbox) SetChecked(checked bool) {
+	c.checked = checked
+}
+
+// Toggle flips the checked state and calls the action
+func (c *Checkbox) Toggle() {
+	if !c.enabled {
+		return
+	}
+
+	c.checked = !c.checked
+	if c.action != nil {
+		c.action(c)
+	}
+}
+
+// PreferredSize fits the box and caption
+func (c *Checkbox) PreferredSize() (w, h float64) {
+	theme := c.Theme()
+	return theme.TextSize + captionWidth(c.caption, c.text, theme.TextSize) + theme.Padding*3.0,
+		theme.TextSize + theme.Padding*2.0
+}
+
+// Draw renders the checkbox
+func (c *Checkbox) Draw(context api.IRenderContext) {
+	theme := c.Theme()
+
+	if c.IsDirty() {
+		size := theme.TextSize
+		pad := theme.Padding
+		y := (c.height - size) / 2.0
+
+		c.check.set(pad, y, pad+size, y+size)
+		c.marker.set(pad+size*0.25, y+size*0.25, pad+size*0.75, y+size*0.75)
+
+		c.frame.transform(context)
+		c.check.transform(context)
+		c.marker.transform(context)
+
+		c.caption.SetScale(size)
+		c.caption.SetPosition(pad*2.0+size, (c.height+size)/2.0)
+		c.SetDirty(false)
+	}
+
+	if c.hovered {
+		c.frame.render(context, theme.Hover, api.FILLED)
+	}
+
+	if c.focused {
+		c.frame.render(context, theme.Focus, api.OUTLINED)
+	}
+
+	c.check.render(context, theme.Background, api.FILLED)
+	c.check.render(context, theme.Border, api.OUTLINED)
+
+	if c.checked {
+		c.marker.render(context, theme.Accent, api.FILLED)
+	}
+
+	c.caption.SetColor(c.textColor())
+}
+
+// Handle processes IO events routed by the root Panel
+func (c *Checkbox) Handle(event api.IEvent) bool {
+	switch event.GetType() {
+	case api.IOTypeMouseMotion:
+		c.trackMouse(event)
+	case api.IOTypeMouseButtonDown:
+		if c.trackMouse(event) {
+			c.Toggle()
+			return true
+		}
+	case api.IOTypeKeyboard:
+		if isPressed(event) && isActivateKey(event) {
+			c.Toggle()
+			return true
+		}
+	}
+
+	return false
+}
+
+func (c Checkbox) String() string {
+	return fmt.Sprintf("%s = '%s' (%v)", c.Node, c.text, c.checked)
+}
@@ -0,0 +1,69 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine/nodes/custom"
+)
+
+// Label is a non-interactive line of text.
+type Label struct {
+	Widget
+
+	text    string
+	caption *custom.VectorTextNode
+}
+
+// NewLabel constructs a label widget
+func NewLabel(name string, world api.IWorld, parent api.INode) api.INode {
+	o := new(Label)
+	o.Initialize(name)
+	o.SetParent(parent)
+	parent.AddChild(o)
+	o.Build(world)
+	return o
+}
+
+// Build configures the widget
+func (l *Label) Build(world api.IWorld) {
+	l.Widget.Build(world)
+
+	l.caption = newCaption(world, l)
+	l.SetSize(l.PreferredSize())
+}
+
+// SetText sets the label's text and resizes the label to fit.
+func (l *Label) SetText(text string) {
+	l.text = text
+	l.caption.SetText(text)
+	l.SetSize(l.PreferredSize())
+}
+
+// Text returns the label's text
+func (l *Label) Text() string {
+	return l.text
+}
+
+// PreferredSize fits the text
+func (l *Label) PreferredSize() (w, h float64) {
+	theme := l.Theme()
+	return captionWidth(l.caption, l.text, theme.TextSize) + theme.Padding*2.0,
+		theme.TextSize + theme.Padding*2.0
+}
+
+// Draw renders the caption in the theme's colors
+func (l *Label) Draw(context api.IRenderContext) {
+	if l.IsDirty() {
+		theme := l.Theme()
+		l.caption.SetScale(theme.TextSize)
+		l.caption.SetPosition(theme.Padding, theme.Padding+theme.TextSize)
+		l.SetDirty(false)
+	}
+
+	l.caption.SetColor(l.textColor())
+}
+
+func (l Label) String() string {
+	return fmt.Sprintf("%s = '%s'", l.Node, l.text)
+}
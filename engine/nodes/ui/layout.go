@@ -0,0 +1,208 @@
+package ui
+
+import (
+	"math"
+
+	"github.com/wdevore/RangerGo/api"
+)
+
+// --------------------------------------------------------------
+// Vertical
+// --------------------------------------------------------------
+
+type verticalLayout struct {
+	spacing float64
+	stretch bool
+}
+
+// NewVerticalLayout stacks children top to bottom. If stretch is
+// true children are widened to the container's content width.
+func NewVerticalLayout(spacing float64, stretch bool) api.ILayout {
+	o := new(verticalLayout)
+	o.spacing = spacing
+	o.stretch = stretch
+	return o
+}
+
+func (l *verticalLayout) Measure(children []api.IWidget) (w, h float64) {
+	for i, child := range children {
+		cw, ch := child.PreferredSize()
+		w = math.Max(w, cw)
+		h += ch
+		if i > 0 {
+			h += l.spacing
+		}
+	}
+	return w, h
+}
+
+func (l *verticalLayout) Arrange(x, y, width, height float64, children []api.IWidget) {
+	for _, child := range children {
+		cw, ch := child.PreferredSize()
+		if l.stretch {
+			cw = width
+		}
+		child.SetSize(cw, ch)
+		child.SetPosition(x, y)
+		y += ch + l.spacing
+	}
+}
+
+// --------------------------------------------------------------
+// Horizontal
+// --------------------------------------------------------------
+
+type horizontalLayout struct {
+	spacing float64
+	stretch bool
+}
+
+// NewHorizontalLayout places children left to right. If stretch is
+// true children are heightened to the container's content height.
+func NewHorizontalLayout(spacing float64, stretch bool) api.ILayout {
+	o := new(horizontalLayout)
+	o.spacing = spacing
+	o.stretch = stretch
+	return o
+}
+
+func (l *horizontalLayout) Measure(children []api.IWidget) (w, h float64) {
+	for i, child := range children {
+		cw, ch := child.PreferredSize()
+		w += cw
+		h = math.Max(h, ch)
+		if i > 0 {
+			w += l.spacing
+		}
+	}
+	return w, h
+}
+
+func (l *horizontalLayout) Arrange(x, y, width, height float64, children []api.IWidget) {
+	for _, child := range children {
+		cw, ch := child.PreferredSize()
+		if l.stretch {
+			ch = height
+		}
+		child.SetSize(cw, ch)
+		child.SetPosition(x, y)
+		x += cw + l.spacing
+	}
+}
+
+// --------------------------------------------------------------
+// Grid
+// --------------------------------------------------------------
+
+type gridLayout struct {
+	columns int
+	spacing float64
+}
+
+// NewGridLayout places children row by row into equally sized cells.
+// The cell size is the largest preferred size of all children.
+func NewGridLayout(columns int, spacing float64) api.ILayout {
+	o := new(gridLayout)
+	o.columns = columns
+	if o.columns < 1 {
+		o.columns = 1
+	}
+	o.spacing = spacing
+	return o
+}
+
+func (l *gridLayout) cell(children []api.IWidget) (w, h float64) {
+	for _, child := range children {
+		cw, ch := child.PreferredSize()
+		w = math.Max(w, cw)
+		h = math.Max(h, ch)
+	}
+	return w, h
+}
+
+func (l *gridLayout) Measure(children []api.IWidget) (w, h float64) {
+	if len(children) == 0 {
+		return 0.0, 0.0
+	}
+
+	cw, ch := l.cell(children)
+	cols := l.columns
+	if len(children) < cols {
+		cols = len(children)
+	}
+	rows := (len(children) + l.columns - 1) / l.columns
+
+	w = float64(cols)*cw + float64(cols-1)*l.spacing
+	h = float64(rows)*ch + float64(rows-1)*l.spacing
+	return w, h
+}
+
+func (l *gridLayout) Arrange(x, y, width, height float64, children []api.IWidget) {
+	cw, ch := l.cell(children)
+
+	for i, child := range children {
+		col := i % l.columns
+		row := i / l.columns
+		child.SetSize(cw, ch)
+		child.SetPosition(x+float64(col)*(cw+l.spacing), y+float64(row)*(ch+l.spacing))
+	}
+}
+
+// --------------------------------------------------------------
+// Anchored
+// --------------------------------------------------------------
+
+type anchorSpec struct {
+	anchor int
+	dx, dy float64
+}
+
+// AnchorLayout pins each child to an edge, corner or the center of
+// the container, offset by (dx,dy). Children without an anchor are
+// placed top-left.
+type AnchorLayout struct {
+	anchors map[api.IWidget]anchorSpec
+}
+
+// NewAnchorLayout constructs an anchored layout
+func NewAnchorLayout() *AnchorLayout {
+	o := new(AnchorLayout)
+	o.anchors = make(map[api.IWidget]anchorSpec)
+	return o
+}
+
+// SetAnchor pins a child, for example, api.AnchorBottomRight
+func (l *AnchorLayout) SetAnchor(child api.IWidget, anchor int, dx, dy float64) {
+	l.anchors[child] = anchorSpec{anchor: anchor, dx: dx, dy: dy}
+}
+
+// Measure returns the smallest area that fits every child at its offset
+func (l *AnchorLayout) Measure(children []api.IWidget) (w, h float64) {
+	for _, child := range children {
+		cw, ch := child.PreferredSize()
+		spec := l.anchors[child]
+		w = math.Max(w, cw+math.Abs(spec.dx))
+		h = math.Max(h, ch+math.Abs(spec.dy))
+	}
+	return w, h
+}
+
+// Arrange positions children relative to their anchors
+func (l *AnchorLayout) Arrange(x, y, width, height float64, children []api.IWidget) {
+	for _, child := range children {
+		cw, ch := child.PreferredSize()
+		child.SetSize(cw, ch)
+
+		spec := l.anchors[child]
+
+		// Column: 0 = left, 1 = center, 2 = right
+		// Row: 0 = top, 1 = middle, 2 = bottom
+		col := spec.anchor % 3
+		row := spec.anchor / 3
+
+		cx := x + (width-cw)*float64(col)/2.0
+		cy := y + (height-ch)*float64(row)/2.0
+
+		child.SetPosition(cx+spec.dx, cy+spec.dy)
+	}
+}
@@ -0,0 +1,243 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/veandco/go-sdl2/sdl"
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine/nodes/custom"
+)
+
+// List is a scrollable list of single line items with one selection.
+// Up/Down move the selection, the mouse wheel scrolls and the action is
+// called when the selection changes.
+type List struct {
+	Widget
+
+	items    []string
+	captions []*custom.VectorTextNode
+
+	selected int
+	// first is the index of the top most visible item
+	first int
+	// rows is the number of visible rows
+	rows int
+
+	highlight *box
+}
+
+// NewList constructs a list widget
+func NewList(name string, world api.IWorld, parent api.INode) api.INode {
+	o := new(List)
+	o.Initialize(name)
+	o.SetParent(parent)
+	parent.AddChild(o)
+	o.Build(world)
+	return o
+}
+
+// Build configures the widget
+func (l *List) Build(world api.IWorld) {
+	l.Widget.Build(world)
+
+	l.focusable = true
+	l.selected = -1
+	l.rows = 5
+	l.highlight = newBox()
+	l.SetSize(l.PreferredSize())
+}
+
+// AddItem appends an item
+func (l *List) AddItem(item string) {
+	l.items = append(l.items, item)
+
+	caption := newCaption(l.World(), l)
+	caption.SetText(item)
+	l.captions = append(l.captions, caption)
+
+	l.SetDirty(true)
+}
+
+// Items returns the list's items
+func (l *List) Items() []string {
+	return l.items
+}
+
+// SetRows sets the number of visible rows used for the preferred size
+func (l *List) SetRows(rows int) {
+	l.rows = rows
+}
+
+// Selected returns the selected index or -1 if nothing is selected
+func (l *List) Selected() int {
+	return l.selected
+}
+
+// SelectedItem returns the selected item or "" if nothing is selected
+func (l *List) SelectedItem() string {
+	if l.selected < 0 {
+		return ""
+	}
+	return l.items[l.selected]
+}
+
+// Select changes the selection, scrolls it into view and calls the action.
+func (l *List) Select(index int) {
+	if index < 0 || index >= len(l.items) || index == l.selected {
+		return
+	}
+
+	l.selected = index
+
+	visible := l.visibleRows()
+	if index < l.first {
+		l.first = index
+	} else if index >= l.first+visible {
+		l.first = index - visible + 1
+	}
+
+	l.SetDirty(true)
+
+	if l.action != nil {
+		l.action(l)
+	}
+}
+
+// ScrollBy moves the visible window by a number of rows
+func (l *List) ScrollBy(rows int) {
+	last := len(l.items) - l.visibleRows()
+	if last < 0 {
+		last = 0
+	}
+
+	l.first += rows
+	if l.first > last {
+		l.first = last
+	}
+	if l.first < 0 {
+		l.first = 0
+	}
+
+	l.SetDirty(true)
+}
+
+// PreferredSize fits the widest item and the number of rows
+func (l *List) PreferredSize() (w, h float64) {
+	theme := l.Theme()
+
+	w = theme.TextSize * 10.0
+	for i, caption := range l.captions {
+		iw := captionWidth(caption, l.items[i], theme.TextSize)
+		if iw > w {
+			w = iw
+		}
+	}
+
+	return w + theme.Padding*2.0, l.rowHeight()*float64(l.rows) + theme.Padding
+}
+
+// Draw renders the frame, highlight and the visible items
+func (l *List) Draw(context api.IRenderContext) {
+	theme := l.Theme()
+
+	if l.IsDirty() {
+		rowHeight := l.rowHeight()
+		visible := l.visibleRows()
+
+		for i, caption := range l.captions {
+			row := i - l.first
+			caption.SetVisible(row >= 0 && row < visible)
+			caption.SetScale(theme.TextSize)
+			caption.SetPosition(theme.Padding, theme.Padding+float64(row)*rowHeight+theme.TextSize)
+		}
+
+		row := float64(l.selected - l.first)
+		top := theme.Padding/2.0 + row*rowHeight
+		l.highlight.set(1.0, top, l.width-1.0, top+rowHeight)
+
+		l.frame.transform(context)
+		l.highlight.transform(context)
+		l.SetDirty(false)
+	}
+
+	l.frame.render(context, theme.Background, api.FILLED)
+
+	row := l.selected - l.first
+	if l.selected >= 0 && row >= 0 && row < l.visibleRows() {
+		l.highlight.render(context, theme.Accent, api.FILLED)
+	}
+
+	l.frame.render(context, l.borderColor(), api.OUTLINED)
+
+	color := l.textColor()
+	for _, caption := range l.captions {
+		caption.SetColor(color)
+	}
+
+	// Long items are clipped to the list.
+	l.clip(context)
+}
+
+// Handle processes IO events routed by the root Panel
+func (l *List) Handle(event api.IEvent) bool {
+	switch event.GetType() {
+	case api.IOTypeMouseMotion:
+		l.trackMouse(event)
+	case api.IOTypeMouseButtonDown:
+		if l.trackMouse(event) {
+			row := int((l.localPoint.Y() - l.Theme().Padding/2.0) / l.rowHeight())
+			l.Select(l.first + row)
+			return true
+		}
+	case api.IOTypeMouseWheel:
+		if l.hovered {
+			_, dy := event.GetMouseRelMovement()
+			l.ScrollBy(-int(dy))
+			return true
+		}
+	case api.IOTypeKeyboard:
+		if !isPressed(event) {
+			return false
+		}
+
+		switch event.GetKeyCode() {
+		case sdl.K_UP:
+			if l.selected <= 0 {
+				return false
+			}
+			l.Select(l.selected - 1)
+		case sdl.K_DOWN:
+			if l.selected >= len(l.items)-1 {
+				return false
+			}
+			l.Select(l.selected + 1)
+		case sdl.K_HOME:
+			l.Select(0)
+		case sdl.K_END:
+			l.Select(len(l.items) - 1)
+		default:
+			return false
+		}
+		return true
+	}
+
+	return false
+}
+
+func (l *List) rowHeight() float64 {
+	theme := l.Theme()
+	return theme.TextSize + theme.Padding
+}
+
+// visibleRows is based on the current height
+func (l *List) visibleRows() int {
+	rows := int((l.height - l.Theme().Padding) / l.rowHeight())
+	if rows < 1 {
+		rows = 1
+	}
+	return rows
+}
+
+func (l List) String() string {
+	return fmt.Sprintf("%s = %d items, selected %d", l.Node, len(l.items), l.selected)
+}
@@ -0,0 +1,353 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/veandco/go-sdl2/sdl"
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine/nodes"
+)
+
+// Panel is a container that arranges its child widgets with a layout.
+// A Panel whose parent isn't a widget is a root panel: it registers for
+// IO events, routes them to its widgets and manages keyboard focus.
+//
+// Tab/Shift+Tab cycle focus. Up/Down also move focus when the focused
+// widget doesn't use them.
+type Panel struct {
+	Widget
+
+	layout api.ILayout
+	// fixed panels keep their size rather than fitting the content
+	fixed bool
+	// transparent panels don't draw a background or border
+	transparent bool
+	// clipping panels restrict their children's drawing to the panel
+	clipping bool
+
+	// focus is only used by root panels
+	focus api.IWidget
+}
+
+// NewPanel constructs a panel with a vertical layout
+func NewPanel(name string, world api.IWorld, parent api.INode) api.INode {
+	o := new(Panel)
+	o.Initialize(name)
+	o.SetParent(parent)
+	parent.AddChild(o)
+	o.Build(world)
+	return o
+}
+
+// Build configures the widget
+func (p *Panel) Build(world api.IWorld) {
+	p.Widget.Build(world)
+
+	p.layout = NewVerticalLayout(p.Theme().Padding, true)
+}
+
+// SetLayout sets the layout used to arrange the child widgets
+func (p *Panel) SetLayout(layout api.ILayout) {
+	p.layout = layout
+}
+
+// SetFixedSize sets a size that Layout won't change
+func (p *Panel) SetFixedSize(w, h float64) {
+	p.fixed = true
+	p.SetSize(w, h)
+}
+
+// SetTransparent hides the panel's background and border
+func (p *Panel) SetTransparent(transparent bool) {
+	p.transparent = transparent
+}
+
+// SetClipping restricts drawing of the children to the panel's bounds,
+// for example, for scrolling content.
+func (p *Panel) SetClipping(clipping bool) {
+	p.clipping = clipping
+}
+
+// PreferredSize fits the layout plus padding
+func (p *Panel) PreferredSize() (w, h float64) {
+	if p.fixed {
+		return p.width, p.height
+	}
+
+	pad := p.Theme().Padding
+	w, h = p.layout.Measure(p.Widgets())
+	return w + pad*2.0, h + pad*2.0
+}
+
+// Widgets returns the child nodes that are widgets
+func (p *Panel) Widgets() []api.IWidget {
+	widgets := []api.IWidget{}
+	for _, child := range p.Children() {
+		if w, ok := child.(api.IWidget); ok {
+			widgets = append(widgets, w)
+		}
+	}
+	return widgets
+}
+
+// Layout sizes the panel, unless fixed, and arranges its children and
+// any nested panels. Call it after adding or changing widgets.
+// Root panels layout automatically when entering the stage.
+func (p *Panel) Layout() {
+	if !p.fixed {
+		p.SetSize(p.PreferredSize())
+	}
+
+	p.arrange()
+}
+
+func (p *Panel) arrange() {
+	pad := p.Theme().Padding
+	widgets := p.Widgets()
+
+	p.layout.Arrange(pad, pad, p.width-pad*2.0, p.height-pad*2.0, widgets)
+
+	for _, w := range widgets {
+		if child, ok := w.(*Panel); ok {
+			child.arrange()
+		}
+	}
+}
+
+// --------------------------------------------------------
+// Lifecycles
+// --------------------------------------------------------
+
+// EnterNode called when a node is entering the stage
+func (p *Panel) EnterNode(man api.INodeManager) {
+	if p.isRoot() {
+		p.Layout()
+		man.RegisterEventTarget(p)
+	}
+}
+
+// ExitNode called when a node is exiting stage
+func (p *Panel) ExitNode(man api.INodeManager) {
+	if p.isRoot() {
+		man.UnRegisterEventTarget(p)
+	}
+}
+
+func (p *Panel) isRoot() bool {
+	_, isWidget := p.Parent().(api.IWidget)
+	return !isWidget
+}
+
+// Draw renders the background
+func (p *Panel) Draw(context api.IRenderContext) {
+	if p.IsDirty() {
+		p.frame.transform(context)
+		p.SetDirty(false)
+	}
+
+	if !p.transparent {
+		theme := p.Theme()
+		p.frame.render(context, theme.Background, api.FILLED)
+		p.frame.render(context, theme.Border, api.OUTLINED)
+	}
+
+	if p.clipping {
+		p.clip(context)
+	}
+}
+
+// --------------------------------------------------------
+// Focus
+// --------------------------------------------------------
+
+// Focused returns the widget with keyboard focus, or nil.
+func (p *Panel) Focused() api.IWidget {
+	return p.focus
+}
+
+// SetFocused gives a widget keyboard focus. nil clears focus.
+func (p *Panel) SetFocused(widget api.IWidget) {
+	if p.focus == widget {
+		return
+	}
+
+	if p.focus != nil {
+		p.focus.SetFocus(false)
+	}
+
+	p.focus = widget
+
+	if widget != nil {
+		widget.SetFocus(true)
+	}
+}
+
+// FocusNext moves focus to the next focusable widget, wrapping around.
+func (p *Panel) FocusNext() {
+	p.moveFocus(1)
+}
+
+// FocusPrevious moves focus to the previous focusable widget, wrapping around.
+func (p *Panel) FocusPrevious() {
+	p.moveFocus(-1)
+}
+
+func (p *Panel) moveFocus(direction int) {
+	chain := p.focusChain(p, nil)
+	if len(chain) == 0 {
+		p.SetFocused(nil)
+		return
+	}
+
+	current := -1
+	for i, w := range chain {
+		if w == p.focus {
+			current = i
+			break
+		}
+	}
+
+	next := 0
+	if current >= 0 {
+		next = (current + direction + len(chain)) % len(chain)
+	} else if direction < 0 {
+		next = len(chain) - 1
+	}
+
+	p.SetFocused(chain[next])
+}
+
+// focusChain collects the focusable widgets in tree order
+func (p *Panel) focusChain(node api.INode, chain []api.IWidget) []api.IWidget {
+	for _, child := range node.Children() {
+		w, ok := child.(api.IWidget)
+		if !ok || !w.IsVisible() || !w.IsEnabled() {
+			continue
+		}
+
+		if w.IsFocusable() {
+			chain = append(chain, w)
+		}
+
+		chain = p.focusChain(w, chain)
+	}
+
+	return chain
+}
+
+// widgetAt returns the top most focusable widget under the mouse
+func (p *Panel) widgetAt(node api.INode, mx, my int32) api.IWidget {
+	children := node.Children()
+
+	for i := len(children) - 1; i >= 0; i-- {
+		w, ok := children[i].(api.IWidget)
+		if !ok || !w.IsVisible() || !w.IsEnabled() {
+			continue
+		}
+
+		if found := p.widgetAt(w, mx, my); found != nil {
+			return found
+		}
+
+		if w.IsFocusable() && p.contains(w, mx, my) {
+			return w
+		}
+	}
+
+	return nil
+}
+
+func (p *Panel) contains(w api.IWidget, mx, my int32) bool {
+	nodes.MapDeviceToNode(mx, my, w, p.localPoint)
+	return w.PointInside(p.localPoint)
+}
+
+// --------------------------------------------------------
+// IO events
+// --------------------------------------------------------
+
+// Handle routes events to the widgets. Only root panels receive events.
+func (p *Panel) Handle(event api.IEvent) bool {
+	// Widgets lose focus when disabled.
+	if p.focus != nil && !p.focus.HasFocus() {
+		p.focus = nil
+	}
+
+	switch event.GetType() {
+	case api.IOTypeKeyboard:
+		return p.handleKey(event)
+	case api.IOTypeTextInput:
+		if p.focus != nil {
+			return p.focus.Handle(event)
+		}
+	case api.IOTypeMouseButtonDown:
+		mx, my := event.GetMousePosition()
+		p.SetFocused(p.widgetAt(p, mx, my))
+		if p.dispatch(p, event) {
+			return true
+		}
+		// Clicks on the panel itself don't fall through to nodes behind it.
+		return p.contains(p, mx, my)
+	default:
+		return p.dispatch(p, event)
+	}
+
+	return false
+}
+
+func (p *Panel) handleKey(event api.IEvent) bool {
+	pressed := isPressed(event)
+
+	if pressed && event.GetKeyCode() == sdl.K_TAB {
+		if event.GetKeyMotif()&sdl.KMOD_SHIFT != 0 {
+			p.FocusPrevious()
+		} else {
+			p.FocusNext()
+		}
+		return true
+	}
+
+	if p.focus != nil && p.focus.Handle(event) {
+		return true
+	}
+
+	if pressed && p.focus != nil {
+		switch event.GetKeyCode() {
+		case sdl.K_DOWN:
+			p.FocusNext()
+			return true
+		case sdl.K_UP:
+			p.FocusPrevious()
+			return true
+		}
+	}
+
+	return false
+}
+
+// dispatch passes a mouse event to widgets, top most first, until
+// one handles it.
+func (p *Panel) dispatch(node api.INode, event api.IEvent) bool {
+	children := node.Children()
+
+	for i := len(children) - 1; i >= 0; i-- {
+		w, ok := children[i].(api.IWidget)
+		if !ok || !w.IsVisible() || !w.IsEnabled() {
+			continue
+		}
+
+		if child, isPanel := w.(*Panel); isPanel {
+			if p.dispatch(child, event) {
+				return true
+			}
+		} else if w.Handle(event) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (p Panel) String() string {
+	return fmt.Sprintf("%s (%d widgets)", p.Node, len(p.Widgets()))
+}
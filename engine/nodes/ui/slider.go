@@ -0,0 +1,197 @@
+package ui
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/veandco/go-sdl2/sdl"
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine/maths"
+)
+
+// Slider is a horizontal value picker. The knob can be dragged with
+// the mouse or moved with the Left/Right/Home/End keys. The action is
+// called whenever the value changes.
+type Slider struct {
+	Widget
+
+	min, max float64
+	value    float64
+	// step is the keyboard increment. Values snap to it if > 0.
+	step float64
+
+	dragging bool
+
+	track *box
+	knob  *box
+}
+
+// NewSlider constructs a slider widget with a range of [0, 1]
+func NewSlider(name string, world api.IWorld, parent api.INode) api.INode {
+	o := new(Slider)
+	o.Initialize(name)
+	o.SetParent(parent)
+	parent.AddChild(o)
+	o.Build(world)
+	return o
+}
+
+// Build configures the widget
+func (s *Slider) Build(world api.IWorld) {
+	s.Widget.Build(world)
+
+	s.focusable = true
+	s.min = 0.0
+	s.max = 1.0
+	s.step = 0.1
+
+	s.track = newBox()
+	s.knob = newBox()
+	s.SetSize(s.PreferredSize())
+}
+
+// SetRange sets the minimum, maximum and keyboard step.
+func (s *Slider) SetRange(min, max, step float64) {
+	s.min = min
+	s.max = max
+	s.step = step
+	s.value = maths.Clamp(s.value, min, max)
+	s.SetDirty(true)
+}
+
+// Value returns the current value
+func (s *Slider) Value() float64 {
+	return s.value
+}
+
+// SetValue sets the value without calling the action. The value
+// is clamped to the range.
+func (s *Slider) SetValue(value float64) {
+	s.value = maths.Clamp(value, s.min, s.max)
+	s.SetDirty(true)
+}
+
+// PreferredSize is a fixed width track
+func (s *Slider) PreferredSize() (w, h float64) {
+	theme := s.Theme()
+	return theme.TextSize * 10.0, theme.TextSize + theme.Padding*2.0
+}
+
+// Draw renders the track and knob
+func (s *Slider) Draw(context api.IRenderContext) {
+	theme := s.Theme()
+
+	if s.IsDirty() {
+		pad := theme.Padding
+		knobWidth := theme.TextSize / 2.0
+		cy := s.height / 2.0
+
+		s.track.set(pad, cy-1.0, s.width-pad, cy+1.0)
+
+		x := s.knobPosition()
+		s.knob.set(x-knobWidth/2.0, pad, x+knobWidth/2.0, s.height-pad)
+
+		s.frame.transform(context)
+		s.track.transform(context)
+		s.knob.transform(context)
+		s.SetDirty(false)
+	}
+
+	if s.focused {
+		s.frame.render(context, theme.Focus, api.OUTLINED)
+	}
+
+	s.track.render(context, theme.Border, api.FILLED)
+
+	knob := theme.Accent
+	if !s.enabled {
+		knob = theme.Disabled
+	} else if s.dragging || s.hovered {
+		knob = theme.Pressed
+	}
+	s.knob.render(context, knob, api.FILLED)
+}
+
+// Handle processes IO events routed by the root Panel
+func (s *Slider) Handle(event api.IEvent) bool {
+	switch event.GetType() {
+	case api.IOTypeMouseMotion:
+		s.trackMouse(event)
+		if s.dragging {
+			s.change(s.valueAt(s.localPoint.X()))
+			return true
+		}
+	case api.IOTypeMouseButtonDown:
+		if s.trackMouse(event) {
+			s.dragging = true
+			s.change(s.valueAt(s.localPoint.X()))
+			return true
+		}
+	case api.IOTypeMouseButtonUp:
+		if s.dragging {
+			s.dragging = false
+			return true
+		}
+	case api.IOTypeKeyboard:
+		if !isPressed(event) {
+			return false
+		}
+
+		switch event.GetKeyCode() {
+		case sdl.K_LEFT:
+			s.change(s.value - s.step)
+		case sdl.K_RIGHT:
+			s.change(s.value + s.step)
+		case sdl.K_HOME:
+			s.change(s.min)
+		case sdl.K_END:
+			s.change(s.max)
+		default:
+			return false
+		}
+		return true
+	}
+
+	return false
+}
+
+// change sets the value and calls the action if it changed.
+func (s *Slider) change(value float64) {
+	if s.step > 0.0 {
+		value = s.min + math.Round((value-s.min)/s.step)*s.step
+	}
+
+	value = maths.Clamp(value, s.min, s.max)
+	if value == s.value {
+		return
+	}
+
+	s.SetValue(value)
+	if s.action != nil {
+		s.action(s)
+	}
+}
+
+// knobPosition maps the value to a local x coordinate
+func (s *Slider) knobPosition() float64 {
+	pad := s.Theme().Padding
+	t := 0.0
+	if s.max > s.min {
+		t = (s.value - s.min) / (s.max - s.min)
+	}
+	return maths.Lerp(pad, s.width-pad, t)
+}
+
+// valueAt maps a local x coordinate to a value
+func (s *Slider) valueAt(x float64) float64 {
+	pad := s.Theme().Padding
+	span := s.width - pad*2.0
+	if span <= 0.0 {
+		return s.min
+	}
+	return maths.Lerp(s.min, s.max, (x-pad)/span)
+}
+
+func (s Slider) String() string {
+	return fmt.Sprintf("%s = %0.3f [%0.3f, %0.3f]", s.Node, s.value, s.min, s.max)
+}
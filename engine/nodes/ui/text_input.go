@@ -0,0 +1,198 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/veandco/go-sdl2/sdl"
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine/geometry"
+	"github.com/wdevore/RangerGo/engine/nodes/custom"
+)
+
+// TextInput is a single line editable text field. Characters arrive
+// as text input events while the field has focus. The action is
+// called when Enter is pressed.
+type TextInput struct {
+	Widget
+
+	text  []rune
+	caret int
+	// maxLength limits the number of characters. A value <= 0 is unlimited.
+	maxLength int
+
+	caption *custom.VectorTextNode
+
+	caretTop, caretBottom api.IPoint
+	o1, o2                api.IPoint
+}
+
+// NewTextInput constructs a text input widget
+func NewTextInput(name string, world api.IWorld, parent api.INode) api.INode {
+	o := new(TextInput)
+	o.Initialize(name)
+	o.SetParent(parent)
+	parent.AddChild(o)
+	o.Build(world)
+	return o
+}
+
+// Build configures the widget
+func (t *TextInput) Build(world api.IWorld) {
+	t.Widget.Build(world)
+
+	t.focusable = true
+	t.caption = newCaption(world, t)
+
+	t.caretTop = geometry.NewPoint()
+	t.caretBottom = geometry.NewPoint()
+	t.o1 = geometry.NewPoint()
+	t.o2 = geometry.NewPoint()
+
+	t.SetSize(t.PreferredSize())
+}
+
+// SetText replaces the text and moves the caret to the end
+func (t *TextInput) SetText(text string) {
+	t.text = []rune(text)
+	if t.maxLength > 0 && len(t.text) > t.maxLength {
+		t.text = t.text[:t.maxLength]
+	}
+	t.caret = len(t.text)
+	t.changed()
+}
+
+// Text returns the current text
+func (t *TextInput) Text() string {
+	return string(t.text)
+}
+
+// SetMaxLength limits the number of characters
+func (t *TextInput) SetMaxLength(length int) {
+	t.maxLength = length
+}
+
+// Caret returns the caret's character index
+func (t *TextInput) Caret() int {
+	return t.caret
+}
+
+// PreferredSize is a fixed width field
+func (t *TextInput) PreferredSize() (w, h float64) {
+	theme := t.Theme()
+	return theme.TextSize * 15.0, theme.TextSize + theme.Padding*2.0
+}
+
+// Draw renders the field and, when focused, the caret
+func (t *TextInput) Draw(context api.IRenderContext) {
+	theme := t.Theme()
+
+	if t.IsDirty() {
+		size := theme.TextSize
+		x := theme.Padding + captionWidth(t.caption, string(t.text[:t.caret]), size)
+
+		t.caretTop.SetByComp(x, theme.Padding)
+		t.caretBottom.SetByComp(x, theme.Padding+size)
+
+		t.frame.transform(context)
+		context.TransformPoints(t.caretTop, t.caretBottom, t.o1, t.o2)
+
+		t.caption.SetScale(size)
+		t.caption.SetPosition(theme.Padding, theme.Padding+size)
+		t.SetDirty(false)
+	}
+
+	t.frame.render(context, theme.Background, api.FILLED)
+	t.frame.render(context, t.borderColor(), api.OUTLINED)
+
+	if t.focused {
+		context.SetDrawColor(theme.Focus)
+		context.RenderLine(t.o1.X(), t.o1.Y(), t.o2.X(), t.o2.Y())
+	}
+
+	t.caption.SetColor(t.textColor())
+
+	// Long text is clipped to the field.
+	t.clip(context)
+}
+
+// Handle processes IO events routed by the root Panel
+func (t *TextInput) Handle(event api.IEvent) bool {
+	switch event.GetType() {
+	case api.IOTypeMouseMotion:
+		t.trackMouse(event)
+	case api.IOTypeMouseButtonDown:
+		return t.trackMouse(event)
+	case api.IOTypeTextInput:
+		t.insert(event.GetText())
+		return true
+	case api.IOTypeKeyboard:
+		if !isPressed(event) {
+			return false
+		}
+		return t.edit(event.GetKeyCode())
+	}
+
+	return false
+}
+
+func (t *TextInput) insert(text string) {
+	for _, c := range text {
+		if t.maxLength > 0 && len(t.text) >= t.maxLength {
+			break
+		}
+
+		t.text = append(t.text, 0)
+		copy(t.text[t.caret+1:], t.text[t.caret:])
+		t.text[t.caret] = c
+		t.caret++
+	}
+
+	t.changed()
+}
+
+// edit handles the editing keys. Printable characters arrive as
+// text input events instead.
+func (t *TextInput) edit(key uint32) bool {
+	switch key {
+	case sdl.K_BACKSPACE:
+		if t.caret > 0 {
+			t.text = append(t.text[:t.caret-1], t.text[t.caret:]...)
+			t.caret--
+		}
+	case sdl.K_DELETE:
+		if t.caret < len(t.text) {
+			t.text = append(t.text[:t.caret], t.text[t.caret+1:]...)
+		}
+	case sdl.K_LEFT:
+		if t.caret > 0 {
+			t.caret--
+		}
+	case sdl.K_RIGHT:
+		if t.caret < len(t.text) {
+			t.caret++
+		}
+	case sdl.K_HOME:
+		t.caret = 0
+	case sdl.K_END:
+		t.caret = len(t.text)
+	case sdl.K_RETURN, sdl.K_KP_ENTER:
+		if t.action != nil {
+			t.action(t)
+		}
+		return true
+	default:
+		return false
+	}
+
+	t.changed()
+	return true
+}
+
+func (t *TextInput) changed() {
+	t.caption.SetText(string(t.text))
+	t.SetDirty(true)
+}
+
+func (t TextInput) String() string {
+	return fmt.Sprintf("%s = '%s'", t.Node, string(t.text))
+}
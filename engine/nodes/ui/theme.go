@@ -0,0 +1,49 @@
+package ui
+
+import (
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine/rendering"
+)
+
+// Theme holds the colors and metrics used to draw widgets.
+// Widgets without a theme use their parent's, and the root falls
+// back to the default theme.
+type Theme struct {
+	Background api.IPalette
+	Hover      api.IPalette
+	Pressed    api.IPalette
+	Foreground api.IPalette
+	Border     api.IPalette
+	Focus      api.IPalette
+	Accent     api.IPalette
+	Disabled   api.IPalette
+
+	// TextSize is the height of captions in widget-space
+	TextSize float64
+	// Padding is the space between a widget's edge and its content
+	Padding float64
+}
+
+var defaultTheme = NewTheme()
+
+// NewTheme constructs a theme with the default colors
+func NewTheme() *Theme {
+	o := new(Theme)
+	o.Background = rendering.NewPaletteInt64(rendering.DarkerGray)
+	o.Hover = rendering.NewPaletteInt64(rendering.DarkGray)
+	o.Pressed = rendering.NewPaletteInt64(rendering.LightGray)
+	o.Foreground = rendering.NewPaletteInt64(rendering.Silver)
+	o.Border = rendering.NewPaletteInt64(rendering.Gray)
+	o.Focus = rendering.NewPaletteInt64(rendering.Yellow)
+	o.Accent = rendering.NewPaletteInt64(rendering.SoftBlue)
+	o.Disabled = rendering.NewPaletteInt64(rendering.LightGray)
+
+	o.TextSize = 12.0
+	o.Padding = 6.0
+	return o
+}
+
+// DefaultTheme returns the theme used when no other theme is set
+func DefaultTheme() *Theme {
+	return defaultTheme
+}
@@ -0,0 +1,201 @@
+package ui
+
+import (
+	"github.com/veandco/go-sdl2/sdl"
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine/geometry"
+	"github.com/wdevore/RangerGo/engine/nodes"
+	"github.com/wdevore/RangerGo/engine/nodes/custom"
+)
+
+// themed is implemented by anything that can supply a theme.
+type themed interface {
+	Theme() *Theme
+}
+
+// Widget is an embedded type used by all widgets. It provides sizing,
+// focus, hover tracking and the widget's background frame.
+type Widget struct {
+	nodes.Node
+
+	width, height float64
+
+	enabled   bool
+	focusable bool
+	focused   bool
+	hovered   bool
+
+	theme  *Theme
+	action api.WidgetAction
+
+	frame      *box
+	bounds     api.IRectangle
+	localPoint api.IPoint
+}
+
+// Build configures the widget
+func (w *Widget) Build(world api.IWorld) {
+	w.Node.Build(world)
+
+	w.enabled = true
+	w.frame = newBox()
+	w.bounds = geometry.NewRectangle()
+	w.localPoint = geometry.NewPoint()
+}
+
+// Size returns the widget's dimensions in local-space
+func (w *Widget) Size() (width, height float64) {
+	return w.width, w.height
+}
+
+// SetSize sets the widget's dimensions in local-space
+func (w *Widget) SetSize(width, height float64) {
+	w.width = width
+	w.height = height
+	w.frame.set(0.0, 0.0, width, height)
+	w.bounds.Set(0.0, 0.0, width, height)
+	w.SetDirty(true)
+}
+
+// PreferredSize defaults to the current size
+func (w *Widget) PreferredSize() (width, height float64) {
+	return w.width, w.height
+}
+
+// IsEnabled indicates if the widget responds to input
+func (w *Widget) IsEnabled() bool {
+	return w.enabled
+}
+
+// SetEnabled enables or disables the widget. Disabled widgets
+// lose focus.
+func (w *Widget) SetEnabled(enabled bool) {
+	w.enabled = enabled
+	if !enabled {
+		w.focused = false
+		w.hovered = false
+	}
+}
+
+// IsFocusable indicates if the widget takes part in keyboard navigation
+func (w *Widget) IsFocusable() bool {
+	return w.focusable
+}
+
+// HasFocus indicates if the widget receives keyboard events
+func (w *Widget) HasFocus() bool {
+	return w.focused
+}
+
+// SetFocus is called by the root Panel as focus moves.
+func (w *Widget) SetFocus(focus bool) {
+	w.focused = focus
+}
+
+// IsHovered indicates if the mouse is over the widget
+func (w *Widget) IsHovered() bool {
+	return w.hovered
+}
+
+// PointInside checks if a local-space point is within the widget
+func (w *Widget) PointInside(p api.IPoint) bool {
+	return p.X() >= 0.0 && p.X() <= w.width && p.Y() >= 0.0 && p.Y() <= w.height
+}
+
+// SetTheme overrides the theme for this widget and its children
+func (w *Widget) SetTheme(theme *Theme) {
+	w.theme = theme
+}
+
+// Theme returns the widget's theme, inherited from the parent if
+// not set.
+func (w *Widget) Theme() *Theme {
+	if w.theme != nil {
+		return w.theme
+	}
+
+	if p, ok := w.Parent().(themed); ok {
+		return p.Theme()
+	}
+
+	return defaultTheme
+}
+
+// SetAction sets the function called when the widget is activated
+// or its value changes.
+func (w *Widget) SetAction(action api.WidgetAction) {
+	w.action = action
+}
+
+// -----------------------------------------------------
+// Internals
+// -----------------------------------------------------
+
+// trackMouse maps the event's mouse position into local-space and
+// updates the hover state. It returns true if the mouse is inside.
+func (w *Widget) trackMouse(event api.IEvent) bool {
+	mx, my := event.GetMousePosition()
+	nodes.MapDeviceToNode(mx, my, w, w.localPoint)
+
+	inside := w.PointInside(w.localPoint)
+	if event.GetType() == api.IOTypeMouseMotion {
+		w.hovered = inside
+	}
+
+	return inside
+}
+
+// clip restricts drawing, including the children's, to the widget's
+// bounds. The clip is removed when the visit of this widget completes.
+func (w *Widget) clip(context api.IRenderContext) {
+	context.PushClip(w.bounds)
+}
+
+// borderColor reflects the focus state
+func (w *Widget) borderColor() api.IPalette {
+	if w.focused {
+		return w.Theme().Focus
+	}
+	return w.Theme().Border
+}
+
+// textColor reflects the enabled state
+func (w *Widget) textColor() api.IPalette {
+	if !w.enabled {
+		return w.Theme().Disabled
+	}
+	return w.Theme().Foreground
+}
+
+// newCaption creates a child vector text node used for widget text.
+// Its name begins with "::" so that PrintTree skips it.
+func newCaption(world api.IWorld, parent api.INode) *custom.VectorTextNode {
+	caption := custom.NewVectorTextNode(world, parent)
+	caption.Initialize("::Caption")
+	caption.SetParent(parent)
+	caption.SetProportional(true)
+	return caption
+}
+
+// captionWidth returns the width of the caption's longest line in
+// widget-space.
+func captionWidth(caption *custom.VectorTextNode, text string, size float64) float64 {
+	if text == "" {
+		return 0.0
+	}
+	return caption.Measure(text) * size
+}
+
+// isPressed checks for a key pressed event
+func isPressed(event api.IEvent) bool {
+	return event.GetType() == api.IOTypeKeyboard && event.GetState() == sdl.PRESSED
+}
+
+// isActivateKey checks for the keys that activate buttons and the like.
+func isActivateKey(event api.IEvent) bool {
+	switch event.GetKeyCode() {
+	case sdl.K_SPACE, sdl.K_RETURN, sdl.K_KP_ENTER:
+		return true
+	}
+	return false
+}
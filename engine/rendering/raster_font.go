@@ -8,6 +8,7 @@ import (
 	"path/filepath"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/wdevore/RangerGo/api"
 )
@@ -16,15 +17,19 @@ import (
 // Font built from glyphs
 // --------------------------------------------------------------
 
+// fallbackGlyph is rendered for any rune the font doesn't contain
+// and the data file doesn't define U+FFFD.
+var fallbackGlyph = []uint8{0x7E, 0x42, 0x42, 0x42, 0x42, 0x42, 0x7E, 0x00}
+
 type rasterFont struct {
 	pixels [][]uint8
-	glyphs map[byte]int // Maps into glyph array
+	glyphs map[rune]int // Maps into glyph array
 }
 
 // NewRasterFont constructs an IRasterFont object
 func NewRasterFont() api.IRasterFont {
 	o := new(rasterFont)
-	o.glyphs = make(map[byte]int)
+	o.glyphs = make(map[rune]int)
 	return o
 }
 
@@ -49,33 +54,50 @@ func (r *rasterFont) Initialize(dataFile string, relativePath string) {
 	for scanner.Scan() {
 		line := scanner.Text()
 
-		// ele[0] is the ascill character itself,
+		if len(line) == 0 {
+			continue
+		}
+
+		// ele[0] is the UTF-8 encoded character itself,
 		// the rest of the line contains the pixels
 		ele := strings.Split(line, " ")
 
 		// Add character to glyph dictionary
-		gIdx := byte(ele[0][0])
+		gIdx, _ := utf8.DecodeRuneInString(ele[0])
 		r.glyphs[gIdx] = idx
 		idx++
 
 		// Add data to raw data array
-		px1, _ := strconv.ParseInt(ele[1], 0, 8)
-		px2, _ := strconv.ParseInt(ele[2], 0, 8)
-		px3, _ := strconv.ParseInt(ele[3], 0, 8)
-		px4, _ := strconv.ParseInt(ele[4], 0, 8)
-		px5, _ := strconv.ParseInt(ele[5], 0, 8)
-		px6, _ := strconv.ParseInt(ele[6], 0, 8)
-		px7, _ := strconv.ParseInt(ele[7], 0, 8)
-		px8, _ := strconv.ParseInt(ele[8], 0, 8)
-
-		px := []uint8{uint8(px1), uint8(px2), uint8(px3), uint8(px4), uint8(px5), uint8(px6), uint8(px7), uint8(px8)}
+		px := make([]uint8, 8)
+		for i := range px {
+			p, _ := strconv.ParseUint(ele[i+1], 0, 8)
+			px[i] = uint8(p)
+		}
 
 		r.pixels = append(r.pixels, px)
 	}
 }
 
-func (r *rasterFont) Glyph(char byte) []uint8 {
-	glyph := r.glyphs[char]
+func (r *rasterFont) Glyph(char rune) []uint8 {
+	glyph, ok := r.glyphs[char]
+	if !ok {
+		return r.fallback()
+	}
+
+	return r.pixels[glyph]
+}
+
+func (r *rasterFont) HasGlyph(char rune) bool {
+	_, ok := r.glyphs[char]
+	return ok
+}
+
+func (r *rasterFont) fallback() []uint8 {
+	glyph, ok := r.glyphs[utf8.RuneError]
+	if !ok {
+		return fallbackGlyph
+	}
+
 	return r.pixels[glyph]
 }
 
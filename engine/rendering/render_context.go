@@ -15,6 +15,23 @@ type renderState struct {
 	drawColor  color.RGBA
 
 	current api.IAffineTransform
+
+	viewport  sdl.Rect
+	viewSpace api.IAffineTransform
+
+	clipDepth int
+
+	target *sdl.Texture
+
+	opacity float64
+	blend   int
+}
+
+// clip is a device-space clipping rectangle. A disabled clip allows
+// drawing everywhere, for example, when a viewport is set.
+type clip struct {
+	enabled bool
+	rect    sdl.Rect
 }
 
 func newRS() *renderState {
@@ -22,6 +39,7 @@ func newRS() *renderState {
 	o.clearColor = NewPaletteInt64(Black).Color()
 	o.drawColor = NewPaletteInt64(White).Color()
 	o.current = maths.NewTransform()
+	o.viewSpace = maths.NewTransform()
 	return o
 }
 
@@ -38,6 +56,21 @@ type renderContext struct {
 
 	current api.IAffineTransform
 	post    api.IAffineTransform // Pre allocated cache
+
+	// The viewport is empty when rendering to the whole window.
+	viewport  sdl.Rect
+	viewSpace api.IAffineTransform
+	view      api.IAffineTransform // Pre allocated cache
+	inverse   api.IAffineTransform // Pre allocated cache
+
+	// The clip stack is maintained in software and mirrored to SDL.
+	clips    []clip
+	clipRect api.IRectangle
+
+	target *sdl.Texture
+
+	opacity float64
+	blend   int
 }
 
 const stackDepth = 100
@@ -54,6 +87,12 @@ func NewRenderContext(world api.IWorld) api.IRenderContext {
 	o.drawColor = NewPaletteInt64(White).Color()
 	o.current = maths.NewTransform()
 	o.post = maths.NewTransform()
+	o.viewSpace = maths.NewTransform()
+	o.view = maths.NewTransform()
+	o.inverse = maths.NewTransform()
+	o.clipRect = geometry.NewRectangle()
+	o.opacity = 1.0
+	o.blend = api.BlendAlpha
 	o.windowSize = world.WindowSize()
 
 	return o
@@ -68,6 +107,7 @@ func (rc *renderContext) Initialize() {
 
 	// Apply centered view-space matrix
 	rc.Apply(rc.world.ViewSpace())
+	rc.viewSpace.SetByTransform(rc.world.ViewSpace())
 }
 
 func (rc *renderContext) Apply(aft api.IAffineTransform) {
@@ -93,6 +133,12 @@ func (rc *renderContext) Save() {
 	top.clearColor = rc.clearColor
 	top.drawColor = rc.drawColor
 	top.current.SetByTransform(rc.current)
+	top.viewport = rc.viewport
+	top.viewSpace.SetByTransform(rc.viewSpace)
+	top.clipDepth = len(rc.clips)
+	top.target = rc.target
+	top.opacity = rc.opacity
+	top.blend = rc.blend
 
 	rc.stackTop++
 }
@@ -104,9 +150,214 @@ func (rc *renderContext) Restore() {
 	rc.clearColor = top.clearColor
 	rc.drawColor = top.drawColor
 	rc.current.SetByTransform(top.current)
+	rc.opacity = top.opacity
 	c := rc.clearColor
 	renderer := rc.world.Renderer()
 	renderer.SetDrawColor(c.R, c.G, c.B, c.A)
+
+	if rc.viewport != top.viewport {
+		rc.viewport = top.viewport
+		rc.viewSpace.SetByTransform(top.viewSpace)
+		rc.applyViewport()
+	}
+
+	if len(rc.clips) != top.clipDepth {
+		rc.clips = rc.clips[:top.clipDepth]
+		rc.applyClip()
+	}
+
+	if rc.target != top.target {
+		rc.target = top.target
+		rc.applyTarget()
+	}
+
+	if rc.blend != top.blend {
+		rc.SetBlendMode(top.blend)
+	}
+}
+
+func (rc *renderContext) SetViewport(rect api.IRectangle) {
+	view := rc.view
+
+	if rect == nil {
+		rc.viewport = sdl.Rect{}
+		view.SetByTransform(rc.world.ViewSpace())
+	} else {
+		w, h := rect.DimesionsAsInt32()
+		rc.viewport = sdl.Rect{X: int32(rect.Min().X()), Y: int32(rect.Min().Y()), W: w, H: h}
+
+		// Same scale as the world's view-space but centered on the viewport.
+		ws, vs := rc.world.WindowSize(), rc.world.ViewSize()
+		view.MakeTranslate(float64(w)/2.0, float64(h)/2.0)
+		view.Scale(ws.X()/vs.X(), ws.Y()/vs.Y())
+	}
+
+	// Swap the view-space portion of the current transform:
+	// current = current * inverse(viewSpace) * view
+	rc.viewSpace.InvertTo(rc.inverse)
+	maths.Multiply(rc.current, rc.inverse, rc.post)
+	maths.Multiply(rc.post, view, rc.current)
+	rc.viewSpace.SetByTransform(view)
+
+	rc.applyViewport()
+
+	// Clips are relative to the viewport so any current clip no longer applies.
+	rc.clips = append(rc.clips, clip{})
+	rc.applyClip()
+}
+
+func (rc *renderContext) applyViewport() {
+	if rc.viewport.W == 0 {
+		rc.world.Renderer().SetViewport(nil)
+	} else {
+		rc.world.Renderer().SetViewport(&rc.viewport)
+	}
+}
+
+func (rc *renderContext) PushClip(rect api.IRectangle) {
+	// The device-space bounds of the transformed corners.
+	min := rect.Min()
+	max := rect.Max()
+
+	minX, minY := math.Inf(1), math.Inf(1)
+	maxX, maxY := math.Inf(-1), math.Inf(-1)
+
+	corners := [4][2]float64{{min.X(), min.Y()}, {max.X(), min.Y()}, {max.X(), max.Y()}, {min.X(), max.Y()}}
+	for _, c := range corners {
+		rc.current.TransformCompToPoint(c[0], c[1], v1)
+		minX = math.Min(minX, v1.X())
+		minY = math.Min(minY, v1.Y())
+		maxX = math.Max(maxX, v1.X())
+		maxY = math.Max(maxY, v1.Y())
+	}
+
+	x, y := int32(math.Floor(minX)), int32(math.Floor(minY))
+	r := sdl.Rect{X: x, Y: y, W: int32(math.Ceil(maxX)) - x, H: int32(math.Ceil(maxY)) - y}
+
+	if n := len(rc.clips); n > 0 && rc.clips[n-1].enabled {
+		parent := rc.clips[n-1].rect
+		if intersection, ok := parent.Intersect(&r); ok {
+			r = intersection
+		} else {
+			// Nothing can be drawn.
+			r = sdl.Rect{X: parent.X, Y: parent.Y}
+		}
+	}
+
+	rc.clips = append(rc.clips, clip{enabled: true, rect: r})
+	rc.applyClip()
+}
+
+func (rc *renderContext) PopClip() {
+	if len(rc.clips) > 0 {
+		rc.clips = rc.clips[:len(rc.clips)-1]
+		rc.applyClip()
+	}
+}
+
+func (rc *renderContext) ClipRect() api.IRectangle {
+	n := len(rc.clips)
+	if n == 0 || !rc.clips[n-1].enabled {
+		return nil
+	}
+
+	r := rc.clips[n-1].rect
+	rc.clipRect.Set(float64(r.X), float64(r.Y), float64(r.X+r.W), float64(r.Y+r.H))
+
+	return rc.clipRect
+}
+
+func (rc *renderContext) applyClip() {
+	renderer := rc.world.Renderer()
+	if renderer == nil {
+		return
+	}
+
+	n := len(rc.clips)
+	if n == 0 || !rc.clips[n-1].enabled {
+		renderer.SetClipRect(nil)
+	} else {
+		renderer.SetClipRect(&rc.clips[n-1].rect)
+	}
+}
+
+func (rc *renderContext) SetBlendMode(mode int) {
+	rc.blend = mode
+
+	if renderer := rc.world.Renderer(); renderer != nil {
+		renderer.SetDrawBlendMode(sdlBlendMode(mode))
+	}
+}
+
+func (rc *renderContext) BlendMode() int {
+	return rc.blend
+}
+
+func (rc *renderContext) SetOpacity(opacity float64) {
+	rc.opacity = maths.Clamp(opacity, 0.0, 1.0)
+}
+
+func (rc *renderContext) Opacity() float64 {
+	return rc.opacity
+}
+
+// alpha applies the current opacity
+func (rc *renderContext) alpha(a uint8) uint8 {
+	return uint8(float64(a) * rc.opacity)
+}
+
+func (rc *renderContext) SetTarget(target *sdl.Texture) {
+	rc.target = target
+	rc.applyTarget()
+
+	if target != nil {
+		renderer := rc.world.Renderer()
+		renderer.SetDrawColor(0, 0, 0, 0)
+		renderer.Clear()
+		renderer.SetDrawColor(rc.drawColor.R, rc.drawColor.G, rc.drawColor.B, rc.alpha(rc.drawColor.A))
+	}
+}
+
+func (rc *renderContext) applyTarget() {
+	rc.world.Renderer().SetRenderTarget(rc.target)
+
+	// SDL resets the viewport and clip when the target changes.
+	rc.applyViewport()
+	rc.applyClip()
+}
+
+func (rc *renderContext) Composite(target *sdl.Texture, tint api.IPalette) {
+	if target == nil {
+		return
+	}
+
+	c := tint.Color()
+	target.SetColorMod(c.R, c.G, c.B)
+	target.SetAlphaMod(rc.alpha(c.A))
+	target.SetBlendMode(sdlBlendMode(rc.blend))
+
+	renderer := rc.world.Renderer()
+
+	if rc.viewport.W == 0 {
+		renderer.Copy(target, nil, nil)
+	} else {
+		// The target holds the whole window so only the viewport's
+		// portion is copied.
+		dst := sdl.Rect{W: rc.viewport.W, H: rc.viewport.H}
+		renderer.Copy(target, &rc.viewport, &dst)
+	}
+}
+
+func sdlBlendMode(blend int) sdl.BlendMode {
+	switch blend {
+	case api.BlendAdditive:
+		return sdl.BLENDMODE_ADD
+	case api.BlendMultiply:
+		return sdl.BLENDMODE_MOD
+	case api.BlendNone:
+		return sdl.BLENDMODE_NONE
+	}
+	return sdl.BLENDMODE_BLEND
 }
 
 func (rc *renderContext) Post() {
@@ -156,7 +407,7 @@ func (rc *renderContext) TransformPolygon(poly api.IPolygon) {
 func (rc *renderContext) SetDrawColor(color api.IPalette) {
 	rc.drawColor = color.Color()
 	renderer := rc.world.Renderer()
-	renderer.SetDrawColor(rc.drawColor.R, rc.drawColor.G, rc.drawColor.B, rc.drawColor.A)
+	renderer.SetDrawColor(rc.drawColor.R, rc.drawColor.G, rc.drawColor.B, rc.alpha(rc.drawColor.A))
 }
 
 func (rc *renderContext) DrawPoint(x, y int32) {
@@ -216,9 +467,9 @@ func (rc *renderContext) DrawCheckerBoard(size int) {
 	for row < h {
 		for col < w {
 			if flip {
-				renderer.SetDrawColor(100, 100, 100, 255)
+				renderer.SetDrawColor(100, 100, 100, rc.alpha(255))
 			} else {
-				renderer.SetDrawColor(80, 80, 80, 255)
+				renderer.SetDrawColor(80, 80, 80, rc.alpha(255))
 			}
 
 			sdlRect.X = col
@@ -311,9 +562,9 @@ func (rc *renderContext) RenderCheckerBoard(mesh api.IMesh, oddColor api.IPalett
 		}
 
 		if flip {
-			renderer.SetDrawColor(oddColor.R(), oddColor.G(), oddColor.B(), oddColor.A())
+			renderer.SetDrawColor(oddColor.R(), oddColor.G(), oddColor.B(), rc.alpha(oddColor.A()))
 		} else {
-			renderer.SetDrawColor(evenColor.R(), evenColor.G(), evenColor.B(), evenColor.A())
+			renderer.SetDrawColor(evenColor.R(), evenColor.G(), evenColor.B(), rc.alpha(evenColor.A()))
 		}
 
 		// upper-left
@@ -356,7 +607,7 @@ func (rc *renderContext) DrawText(x, y float64, text string, scale int, fill int
 		}
 
 		gy := int32(y) // move y back to the "top" for each char
-		glyph := rasterFont.Glyph(byte(c))
+		glyph := rasterFont.Glyph(c)
 
 		for _, g := range glyph {
 			gx := cx // set to current column
@@ -384,3 +635,51 @@ func (rc *renderContext) DrawText(x, y float64, text string, scale int, fill int
 		cx += rowWidth * s // move to next column/char/glyph
 	}
 }
+
+var sdlCenter = &sdl.Point{}
+
+func (rc *renderContext) RenderTexture(texture *sdl.Texture, x, y, w, h float64) {
+	if texture == nil {
+		return
+	}
+
+	// Decompose the current transform into rotation and scale so that
+	// SDL can rotate the texture about its upper-left corner.
+	a, b, c, d, _, _ := rc.current.Components()
+	sx := math.Sqrt(a*a + b*b)
+	sy := math.Sqrt(c*c + d*d)
+	radians := math.Atan2(b, a)
+	angle := radians / maths.DegreeToRadians
+
+	// A mirroring transform (negative determinant) reverses the y-axis
+	// relative to the rotated x-axis, i.e. sy is negative.
+	flip := sdl.FLIP_NONE
+	if a*d-b*c < 0.0 {
+		flip = sdl.FLIP_VERTICAL
+		sy = -sy
+	}
+
+	v1.SetByComp(x, y)
+	rc.current.TransformToPoint(v1, v2)
+
+	// SDL flips within the destination rectangle, so a flipped
+	// rectangle starts h*sy along the rotated y-axis to keep the
+	// texture's top edge at (x, y).
+	px, py := v2.X(), v2.Y()
+	if sy < 0.0 {
+		px -= h * sy * math.Sin(radians)
+		py += h * sy * math.Cos(radians)
+	}
+
+	sdlRect.X = int32(math.Round(px))
+	sdlRect.Y = int32(math.Round(py))
+	sdlRect.W = int32(math.Round(w * sx))
+	sdlRect.H = int32(math.Round(h * math.Abs(sy)))
+
+	texture.SetColorMod(rc.drawColor.R, rc.drawColor.G, rc.drawColor.B)
+	texture.SetAlphaMod(rc.alpha(rc.drawColor.A))
+	texture.SetBlendMode(sdlBlendMode(rc.blend))
+
+	renderer := rc.world.Renderer()
+	renderer.CopyEx(texture, nil, sdlRect, angle, sdlCenter, flip)
+}
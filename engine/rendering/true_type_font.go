@@ -0,0 +1,171 @@
+package rendering
+
+import (
+	"fmt"
+	"log"
+	"path/filepath"
+
+	"github.com/veandco/go-sdl2/sdl"
+	"github.com/veandco/go-sdl2/ttf"
+	"github.com/wdevore/RangerGo/api"
+)
+
+// --------------------------------------------------------------
+// Internal glyph
+// --------------------------------------------------------------
+
+type trueTypeGlyph struct {
+	texture *sdl.Texture
+	width   int
+	height  int
+	advance int
+}
+
+// --------------------------------------------------------------
+// Font rasterized by SDL_ttf
+// --------------------------------------------------------------
+
+type trueTypeFont struct {
+	world api.IWorld
+
+	fontPath string
+	size     int
+
+	font *ttf.Font
+
+	glyphs  map[rune]*trueTypeGlyph
+	kerning map[[2]rune]int
+}
+
+// NewTrueTypeFont constructs an ITrueTypeFont object
+func NewTrueTypeFont(world api.IWorld) api.ITrueTypeFont {
+	o := new(trueTypeFont)
+	o.world = world
+	o.glyphs = make(map[rune]*trueTypeGlyph)
+	o.kerning = make(map[[2]rune]int)
+	return o
+}
+
+func (t *trueTypeFont) Initialize(fontFile string, size int, relativePath string) {
+	dataPath, err := filepath.Abs(relativePath)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	t.fontPath = dataPath + "/assets/" + fontFile
+	t.size = size
+}
+
+// open lazily opens the font. SDL_ttf must be initialized first which
+// may not have happened yet when nodes are built.
+func (t *trueTypeFont) open() *ttf.Font {
+	if t.font != nil {
+		return t.font
+	}
+
+	if !ttf.WasInit() {
+		if err := ttf.Init(); err != nil {
+			log.Fatalf("TrueTypeFont: failed initializing SDL_ttf: %s", err)
+		}
+	}
+
+	font, err := ttf.OpenFont(t.fontPath, t.size)
+	if err != nil {
+		log.Fatalf("TrueTypeFont: failed opening file: %s", err)
+	}
+
+	font.SetKerning(true)
+	t.font = font
+	fmt.Println("Opened true type font file")
+
+	return t.font
+}
+
+func (t *trueTypeFont) Destroy() {
+	for _, g := range t.glyphs {
+		if g.texture != nil {
+			g.texture.Destroy()
+		}
+	}
+
+	t.glyphs = make(map[rune]*trueTypeGlyph)
+	t.kerning = make(map[[2]rune]int)
+
+	if t.font != nil {
+		t.font.Close()
+		t.font = nil
+	}
+}
+
+func (t *trueTypeFont) Height() int {
+	return t.open().Height()
+}
+
+func (t *trueTypeFont) Ascent() int {
+	return t.open().Ascent()
+}
+
+func (t *trueTypeFont) LineSkip() int {
+	return t.open().LineSkip()
+}
+
+func (t *trueTypeFont) Advance(char rune) int {
+	return t.glyph(char).advance
+}
+
+// Kerning is derived from the size of the pair minus each glyph's
+// advance because SDL_ttf doesn't expose pair kerning directly.
+func (t *trueTypeFont) Kerning(prev, char rune) int {
+	pair := [2]rune{prev, char}
+
+	if k, ok := t.kerning[pair]; ok {
+		return k
+	}
+
+	w, _, err := t.open().SizeUTF8(string(pair[:]))
+	k := 0
+	if err == nil {
+		k = w - t.Advance(prev) - t.Advance(char)
+	}
+
+	t.kerning[pair] = k
+
+	return k
+}
+
+func (t *trueTypeFont) Glyph(char rune) (texture *sdl.Texture, width, height int) {
+	g := t.glyph(char)
+	return g.texture, g.width, g.height
+}
+
+// glyph returns a cached glyph, rasterizing it on first use.
+func (t *trueTypeFont) glyph(char rune) *trueTypeGlyph {
+	if g, ok := t.glyphs[char]; ok {
+		return g
+	}
+
+	font := t.open()
+	g := new(trueTypeGlyph)
+
+	metrics, err := font.GlyphMetrics(char)
+	if err == nil {
+		g.advance = metrics.Advance
+	}
+
+	// Glyphs are rendered white so that the render context can tint
+	// them using the current draw color.
+	surface, err := font.RenderUTF8Blended(string(char), sdl.Color{R: 255, G: 255, B: 255, A: 255})
+	if err == nil {
+		g.width = int(surface.W)
+		g.height = int(surface.H)
+		g.texture, err = t.world.Renderer().CreateTextureFromSurface(surface)
+		if err != nil {
+			fmt.Println("TrueTypeFont: unable to create glyph texture: ", err)
+		}
+		surface.Free()
+	}
+
+	t.glyphs[char] = g
+
+	return g
+}
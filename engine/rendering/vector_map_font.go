@@ -4,10 +4,12 @@ import (
 	"bufio"
 	"fmt"
 	"log"
+	"math"
 	"os"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"unicode/utf8"
 
 	"github.com/wdevore/RangerGo/api"
 )
@@ -18,6 +20,8 @@ import (
 
 type vectorMapGlyph struct {
 	vertices []float64
+
+	minX, maxX float64
 }
 
 func newVectorMapGlyph() *vectorMapGlyph {
@@ -27,6 +31,13 @@ func newVectorMapGlyph() *vectorMapGlyph {
 }
 
 func (vg *vectorMapGlyph) addVector(x1, y1, x2, y2 float64) {
+	if len(vg.vertices) == 0 {
+		vg.minX = math.Min(x1, x2)
+		vg.maxX = math.Max(x1, x2)
+	} else {
+		vg.minX = math.Min(vg.minX, math.Min(x1, x2))
+		vg.maxX = math.Max(vg.maxX, math.Max(x1, x2))
+	}
 	vg.vertices = append(vg.vertices, x1, y1, x2, y2)
 }
 
@@ -36,7 +47,8 @@ func (vg *vectorMapGlyph) addVector(x1, y1, x2, y2 float64) {
 
 type vectorMapFont struct {
 	vectors []*vectorMapGlyph
-	glyphs  map[byte]int // Maps into glyph array
+	glyphs  map[rune]int // Maps into glyph array
+	kerning map[[2]rune]float64
 
 	horizontalOffset float64
 	verticalOffset   float64
@@ -47,7 +59,8 @@ type vectorMapFont struct {
 func NewVectorMapFont() api.IVectorFont {
 	o := new(vectorMapFont)
 	o.vectors = []*vectorMapGlyph{}
-	o.glyphs = make(map[byte]int)
+	o.glyphs = make(map[rune]int)
+	o.kerning = make(map[[2]rune]float64)
 	o.scale = 3.0
 	return o
 }
@@ -88,9 +101,15 @@ func (v *vectorMapFont) Initialize(dataFile string, relativePath string) {
 			continue
 		}
 
-		if len(line) == 1 {
+		// Optional kerning pairs: "kern AV -0.15"
+		if strings.HasPrefix(line, "kern ") {
+			v.addKerning(line)
+			continue
+		}
+
+		if utf8.RuneCountInString(line) == 1 {
 			// Add character to glyph dictionary
-			gIdx := byte(line[0])
+			gIdx, _ := utf8.DecodeRuneInString(line)
 			v.glyphs[gIdx] = idx
 			// Start new glyph for character
 			glyph = newVectorMapGlyph()
@@ -128,7 +147,74 @@ func (v *vectorMapFont) Scale() float64 {
 	return v.scale
 }
 
-func (v *vectorMapFont) Glyph(char byte) []float64 {
-	glyph := v.glyphs[char]
-	return v.vectors[glyph].vertices
+func (v *vectorMapFont) Glyph(char rune) []float64 {
+	glyph := v.glyph(char)
+	if glyph == nil {
+		return fallbackVectorGlyph
+	}
+
+	return glyph.vertices
+}
+
+func (v *vectorMapFont) addKerning(line string) {
+	ele := strings.Fields(line)
+	if len(ele) != 3 || utf8.RuneCountInString(ele[1]) != 2 {
+		fmt.Println("VectorMapFont: ignoring malformed kerning: ", line)
+		return
+	}
+
+	k, err := strconv.ParseFloat(ele[2], 64)
+	if err != nil {
+		fmt.Println("VectorMapFont: ignoring malformed kerning: ", line)
+		return
+	}
+
+	pair := []rune(ele[1])
+	v.kerning[[2]rune{pair[0], pair[1]}] = k
+}
+
+func (v *vectorMapFont) Extents(char rune) (minX, maxX float64) {
+	glyph := v.glyph(char)
+	if glyph == nil {
+		return -0.4, 0.4
+	}
+
+	return glyph.minX, glyph.maxX
+}
+
+func (v *vectorMapFont) Advance(char rune) float64 {
+	// The fixed offset includes the spacing between unit wide glyphs.
+	spacing := v.horizontalOffset - 1.0
+
+	minX, maxX := v.Extents(char)
+	if minX == maxX {
+		// Empty glyphs, such as space, take half the fixed offset
+		return v.horizontalOffset / 2.0
+	}
+
+	return maxX - minX + spacing
+}
+
+func (v *vectorMapFont) Kerning(prev, char rune) float64 {
+	return v.kerning[[2]rune{prev, char}]
+}
+
+func (v *vectorMapFont) HasGlyph(char rune) bool {
+	_, ok := v.glyphs[char]
+	return ok
+}
+
+// glyph returns the char's glyph, falling back to the font's '?'
+// glyph. It returns nil if the font has neither, in which case an
+// empty box is drawn.
+func (v *vectorMapFont) glyph(char rune) *vectorMapGlyph {
+	glyph, ok := v.glyphs[char]
+	if !ok {
+		glyph, ok = v.glyphs['?']
+		if !ok {
+			return nil
+		}
+	}
+
+	return v.vectors[glyph]
 }
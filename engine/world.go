@@ -9,6 +9,7 @@ import (
 	"github.com/wdevore/RangerGo/api"
 	"github.com/wdevore/RangerGo/engine/geometry"
 	"github.com/wdevore/RangerGo/engine/maths"
+	"github.com/wdevore/RangerGo/engine/nodes"
 	"github.com/wdevore/RangerGo/engine/rendering"
 )
 
@@ -32,6 +33,8 @@ type world struct {
 	rasterFont api.IRasterFont
 
 	workingPath string
+
+	registry api.INodeRegistry
 }
 
 // NewWorld constructs an IWorld object
@@ -46,6 +49,8 @@ func NewWorld(title string, viewScale float64, relativePath string) api.IWorld {
 	o.viewSize = geometry.NewPointUsing(o.windowSize.X()*viewScale, o.windowSize.Y()*viewScale)
 	o.viewCentered = true
 
+	o.registry = nodes.NewNodeRegistry()
+
 	o.viewSpace = maths.NewTransform()
 	o.invViewSpace = maths.NewTransform()
 
@@ -140,3 +145,7 @@ func (w *world) ViewSpace() api.IAffineTransform {
 func (w *world) InvViewSpace() api.IAffineTransform {
 	return w.invViewSpace
 }
+
+func (w *world) NodeRegistry() api.INodeRegistry {
+	return w.registry
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine/animation/actions"
+	"github.com/wdevore/RangerGo/engine/animation/tweening"
+	"github.com/wdevore/RangerGo/engine/maths"
+	"github.com/wdevore/RangerGo/engine/nodes"
+	"github.com/wdevore/RangerGo/engine/nodes/custom"
+	"github.com/wdevore/RangerGo/engine/rendering"
+)
+
+type gameLayer struct {
+	nodes.Node
+
+	square api.INode
+	circle api.INode
+	status *custom.RasterTextNode
+
+	laps int
+}
+
+func newBasicGameLayer(name string, parent api.INode) api.INode {
+	o := new(gameLayer)
+	o.Initialize(name)
+	o.SetParent(parent)
+	parent.AddChild(o)
+	return o
+}
+
+func (g *gameLayer) Build(world api.IWorld) {
+	g.Node.Build(world)
+
+	g.square = custom.NewRectangleNode("Orange Rect", world, g)
+	g.square.(*custom.RectangleNode).SetColor(rendering.NewPaletteInt64(rendering.Orange))
+	g.square.SetScale(100.0)
+	g.square.SetPosition(-300.0, -150.0)
+
+	g.circle = custom.NewCircleNode("Circle", world, g)
+	g.circle.(*custom.CircleNode).SetColor(rendering.NewPaletteInt64(rendering.SoftGreen))
+	g.circle.SetScale(50.0)
+	g.circle.SetPosition(0.0, 150.0)
+
+	text := custom.NewRasterTextNode("Status", world, g)
+	g.status = text.(*custom.RasterTextNode)
+	g.status.SetText("Laps: 0")
+	g.status.SetFontScale(2)
+	g.status.SetFill(1)
+	g.status.SetPosition(15.0, 50.0) // Note these coords are in device-space
+	g.status.SetColor(rendering.NewPaletteInt64(rendering.White))
+}
+
+// -----------------------------------------------------
+// Node lifecycles
+// -----------------------------------------------------
+
+// EnterNode called when a node is entering the stage
+func (g *gameLayer) EnterNode(man api.INodeManager) {
+	easeOut := tweening.NewExpoEquation(api.EaseOut)
+
+	// The square laps a rectangle, spinning and changing color on
+	// the way back.
+	lap := actions.Sequence(
+		actions.Ease(easeOut, actions.MoveBy(1000.0, 600.0, 0.0)),
+		actions.MoveBy(500.0, 0.0, 300.0),
+		actions.Parallel(
+			actions.MoveBy(1000.0, -600.0, 0.0),
+			actions.RotateBy(1000.0, maths.DegreeToRadians*180.0),
+			actions.TintTo(1000.0, rendering.NewPaletteInt64(rendering.SoftBlue)),
+		),
+		actions.MoveBy(500.0, 0.0, -300.0),
+		actions.TintTo(250.0, rendering.NewPaletteInt64(rendering.Orange)),
+		actions.Call(func(target api.INode) { g.lap() }),
+	)
+	man.Actions().Run(g.square, actions.RepeatForever(lap))
+
+	// The circle pulses and fades three times, then hides.
+	pulse := actions.Sequence(
+		actions.Parallel(actions.ScaleTo(400.0, 75.0), actions.FadeTo(400.0, 0.25)),
+		actions.Parallel(actions.ScaleTo(400.0, 50.0), actions.FadeTo(400.0, 1.0)),
+		actions.Delay(200.0),
+	)
+	man.Actions().Run(g.circle, actions.Sequence(
+		actions.Delay(1000.0),
+		actions.Repeat(3, pulse),
+		actions.Call(func(target api.INode) { target.SetVisible(false) }),
+	))
+}
+
+func (g *gameLayer) lap() {
+	g.laps++
+	g.status.SetText(fmt.Sprintf("Laps: %d", g.laps))
+}
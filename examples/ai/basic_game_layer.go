@@ -0,0 +1,226 @@
+package main
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine/ai/behavior"
+	"github.com/wdevore/RangerGo/engine/ai/fsm"
+	"github.com/wdevore/RangerGo/engine/animation/actions"
+	"github.com/wdevore/RangerGo/engine/geometry"
+	"github.com/wdevore/RangerGo/engine/nodes"
+	"github.com/wdevore/RangerGo/engine/nodes/custom"
+	"github.com/wdevore/RangerGo/engine/rendering"
+)
+
+const (
+	sightRange = 200.0
+	loseRange  = 300.0
+	chaseSpeed = 0.2 // units per millisecond
+	walkSpeed  = 0.1
+)
+
+type gameLayer struct {
+	nodes.Node
+
+	cursorPosition api.IPoint
+	crossNode      api.INode
+
+	runner api.IActionRunner
+
+	// The guard patrols, chases the cursor when it comes close and
+	// searches where it lost it.
+	guard        api.INode
+	patrol       api.IPath
+	brain        *fsm.Machine
+	guardOverlay api.INode
+
+	// The worker walks to random spots and works until it must rest.
+	worker        api.INode
+	tree          *behavior.Tree
+	workerOverlay api.INode
+}
+
+func newBasicGameLayer(name string, parent api.INode) api.INode {
+	o := new(gameLayer)
+	o.Initialize(name)
+	o.SetParent(parent)
+	parent.AddChild(o)
+	return o
+}
+
+func (g *gameLayer) Build(world api.IWorld) {
+	g.Node.Build(world)
+
+	g.cursorPosition = geometry.NewPoint()
+	g.crossNode = custom.NewCrossNode("Cross", world, g)
+	g.crossNode.SetScale(30.0)
+
+	g.buildGuard(world)
+	g.buildWorker(world)
+}
+
+func (g *gameLayer) buildGuard(world api.IWorld) {
+	g.patrol = geometry.NewCatmullRomPath()
+	g.patrol.AddPoint(-500.0, -200.0)
+	g.patrol.AddPoint(-100.0, -250.0)
+	g.patrol.AddPoint(-150.0, 200.0)
+	g.patrol.AddPoint(-450.0, 150.0)
+	g.patrol.SetClosed(true)
+	g.patrol.Build()
+
+	n := custom.NewPathNode("Patrol", world, g)
+	n.(*custom.PathNode).SetPath(g.patrol)
+
+	g.guard = custom.NewCircleNode("Guard", world, g)
+	g.guard.(*custom.CircleNode).SetColor(rendering.NewPaletteInt64(rendering.Red))
+	g.guard.SetScale(15.0)
+	start := g.patrol.Points()[0]
+	g.guard.SetPosition(start.X(), start.Y())
+
+	g.brain = fsm.NewMachine(g.guard)
+
+	g.brain.AddState(fsm.NewState("patrol").
+		OnEnter(func(owner api.INode) {
+			g.runner.Run(owner, actions.RepeatForever(actions.FollowPath(g.patrol, 120.0, false)))
+		}).
+		OnExit(func(owner api.INode) {
+			g.runner.Stop(owner)
+		}))
+
+	g.brain.AddState(fsm.NewState("chase").
+		OnUpdate(func(owner api.INode, dt float64) {
+			moveToward(owner, g.cursorPosition, chaseSpeed*dt)
+		}))
+
+	// Searching, the guard waits where it lost the cursor.
+	g.brain.AddState(fsm.NewState("search"))
+
+	// Returning, the guard walks back to the start of its patrol.
+	g.brain.AddState(fsm.NewState("return").
+		OnUpdate(func(owner api.INode, dt float64) {
+			moveToward(owner, start, walkSpeed*dt)
+		}))
+
+	g.brain.AddTransition("chase", "search", func() bool { return g.cursorDistance() > loseRange })
+	g.brain.AddTransition("search", "return", func() bool { return g.brain.Elapsed() > 2000.0 })
+	g.brain.AddTransition("return", "patrol", func() bool {
+		p := g.guard.Position()
+		return p.X() == start.X() && p.Y() == start.Y()
+	})
+	// Spotting the cursor interrupts anything else
+	g.brain.AddTransition("", "chase", func() bool { return g.cursorDistance() < sightRange })
+
+	g.guardOverlay = custom.NewDebugOverlayNode("Guard Overlay", world, g)
+	g.guardOverlay.(*custom.DebugOverlayNode).SetSource(g.brain)
+}
+
+func (g *gameLayer) buildWorker(world api.IWorld) {
+	g.worker = custom.NewCircleNode("Worker", world, g)
+	g.worker.(*custom.CircleNode).SetColor(rendering.NewPaletteInt64(rendering.SoftGreen))
+	g.worker.SetScale(15.0)
+	g.worker.SetPosition(300.0, 0.0)
+
+	target := geometry.NewPoint()
+
+	rest := behavior.Sequence("Rest",
+		behavior.Condition("Tired?", func(board api.IBlackboard) bool {
+			return board.Get("energy").(int) <= 0
+		}),
+		behavior.Wait(3000.0),
+		behavior.Action("Recover", func(board api.IBlackboard, dt float64) int {
+			board.Set("energy", 3)
+			return api.BehaviorSuccess
+		}))
+
+	work := behavior.Sequence("Work",
+		behavior.Action("Pick spot", func(board api.IBlackboard, dt float64) int {
+			target.SetByComp(150.0+rand.Float64()*350.0, -250.0+rand.Float64()*500.0)
+			return api.BehaviorSuccess
+		}),
+		behavior.Action("Walk", func(board api.IBlackboard, dt float64) int {
+			if moveToward(g.worker, target, walkSpeed*dt) {
+				return api.BehaviorSuccess
+			}
+			return api.BehaviorRunning
+		}),
+		behavior.Wait(1000.0),
+		behavior.Action("Use energy", func(board api.IBlackboard, dt float64) int {
+			board.Set("energy", board.Get("energy").(int)-1)
+			return api.BehaviorSuccess
+		}))
+
+	g.tree = behavior.NewTree(behavior.Selector("Worker", rest, work))
+	g.tree.Blackboard().Set("energy", 3)
+
+	g.workerOverlay = custom.NewDebugOverlayNode("Worker Overlay", world, g)
+	g.workerOverlay.(*custom.DebugOverlayNode).SetSource(g.tree)
+}
+
+func (g *gameLayer) cursorDistance() float64 {
+	p := g.guard.Position()
+	return math.Hypot(g.cursorPosition.X()-p.X(), g.cursorPosition.Y()-p.Y())
+}
+
+// moveToward moves a node up to "step" units toward a target and
+// returns true once there.
+func moveToward(node api.INode, target api.IPoint, step float64) bool {
+	p := node.Position()
+	dx, dy := target.X()-p.X(), target.Y()-p.Y()
+	d := math.Hypot(dx, dy)
+
+	if d <= step {
+		node.SetPosition(target.X(), target.Y())
+		return true
+	}
+
+	node.SetPosition(p.X()+dx/d*step, p.Y()+dy/d*step)
+	return false
+}
+
+// Update drives the guard's state machine and the worker's tree, and
+// keeps their overlays above them.
+func (g *gameLayer) Update(msPerUpdate, secPerUpdate float64) {
+	g.brain.Update(msPerUpdate)
+	g.tree.Update(msPerUpdate)
+
+	p := g.guard.Position()
+	g.guardOverlay.SetPosition(p.X()-20.0, p.Y()-40.0)
+	p = g.worker.Position()
+	g.workerOverlay.SetPosition(p.X()-20.0, p.Y()-90.0)
+}
+
+// -----------------------------------------------------
+// Node lifecycles
+// -----------------------------------------------------
+
+// EnterNode called when a node is entering the stage
+func (g *gameLayer) EnterNode(man api.INodeManager) {
+	g.runner = man.Actions()
+	g.brain.Start("patrol")
+
+	man.RegisterTarget(g)
+	man.RegisterEventTarget(g)
+}
+
+// ExitNode called when a node is exiting stage
+func (g *gameLayer) ExitNode(man api.INodeManager) {
+	man.UnRegisterTarget(g)
+	man.UnRegisterEventTarget(g)
+}
+
+// -----------------------------------------------------
+// IO events
+// -----------------------------------------------------
+
+func (g *gameLayer) Handle(event api.IEvent) bool {
+	if event.GetType() == api.IOTypeMouseMotion {
+		mx, my := event.GetMousePosition()
+		nodes.MapDeviceToView(g.World(), mx, my, g.cursorPosition)
+
+		g.crossNode.SetPosition(g.cursorPosition.X(), g.cursorPosition.Y())
+	}
+
+	return false
+}
@@ -3,10 +3,9 @@ package main
 import (
 	"fmt"
 
-	"github.com/tanema/gween"
-	"github.com/tanema/gween/ease"
 	"github.com/wdevore/RangerGo/api"
 	"github.com/wdevore/RangerGo/engine/animation"
+	"github.com/wdevore/RangerGo/engine/animation/tweening"
 	"github.com/wdevore/RangerGo/engine/geometry"
 	"github.com/wdevore/RangerGo/engine/maths"
 	"github.com/wdevore/RangerGo/engine/nodes"
@@ -31,10 +30,7 @@ type gameLayer struct {
 	angularMotion api.IMotion
 
 	// Ranger's tweening framework
-	// tween api.ITween
-
-	// Tanema's framework
-	tween *gween.Tween
+	tween api.ITween
 }
 
 func newBasicGameLayer(name string, parent api.INode) api.INode {
@@ -78,24 +74,20 @@ func (g *gameLayer) Build(world api.IWorld) {
 	g.crossNode = custom.NewCrossNode("Cross", world, g)
 	g.crossNode.SetScale(30.0)
 
-	// 5s = 5000ms
-	g.tween = gween.New(float32(g.rectNode.Position().X()), -600.0, 5000, ease.OutExpo)
-
-	// g.tween = tweening.NewTween(g.rectNode.Position().X(), -600.0, 5000, api.EquationExpo, api.EaseOut)
+	// 5s = 5000ms. The rectangle bounces to the left and then back
+	// again, forever.
+	g.tween = tweening.NewTween(g.rectNode.Position().X(), -600.0, 5000, api.EquationBounce, api.EaseOut)
+	g.tween.SetYoyo(true)
+	g.tween.SetRepeat(api.RepeatForever)
+	g.tween.SetDelay(500.0)
 }
 
 // Update updates the time properties of a node.
 func (g *gameLayer) Update(msPerUpdate, secPerUpdate float64) {
 	g.angularMotion.Update(msPerUpdate)
 
-	value, isFinished := g.tween.Update(float32(msPerUpdate))
-	// value, isFinished := g.tween.Update(msPerUpdate)
-
-	if !isFinished {
-		g.rectNode.SetPosition(float64(value), g.rectNode.Position().Y())
-	} else {
-		g.tween.Reset()
-	}
+	value, _ := g.tween.Update(msPerUpdate)
+	g.rectNode.SetPosition(value, g.rectNode.Position().Y())
 }
 
 // Interpolate is used for blending time based properties.
@@ -8,8 +8,7 @@ import (
 
 var ranger api.IEngine
 
-// Note: This uses Ranger's minimalistic tweening framework.
-// It is suggested that you use github.com/tanema/gween
+// Note: This uses Ranger's tweening framework.
 
 func init() {
 	world := engine.NewWorld("Basic tweening", 1.5, "..")
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"math"
+
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine/animation"
+	"github.com/wdevore/RangerGo/engine/maths"
+	"github.com/wdevore/RangerGo/engine/nodes"
+	"github.com/wdevore/RangerGo/engine/nodes/custom"
+	"github.com/wdevore/RangerGo/engine/rendering"
+)
+
+type gameLayer struct {
+	nodes.Node
+
+	camera *custom.CameraNode
+	ship   api.INode
+
+	// The ship orbits the world origin
+	orbit api.IMotion
+}
+
+func newBasicGameLayer(name string, parent api.INode) api.INode {
+	o := new(gameLayer)
+	o.Initialize(name)
+	o.SetParent(parent)
+	parent.AddChild(o)
+	return o
+}
+
+func (g *gameLayer) Build(world api.IWorld) {
+	g.Node.Build(world)
+
+	g.camera = custom.NewCameraNode("Camera", world, g).(*custom.CameraNode)
+
+	// The world is larger than the view so that the camera can roam.
+	board := custom.NewCheckBoardNode("CheckBoard", world, g.camera)
+	board.(*custom.CheckerBoardNode).Configure(50.0)
+	board.SetScale(2.0)
+
+	vw, vh := world.ViewSize().Components()
+
+	g.ship = custom.NewRectangleNode("Ship", world, g.camera)
+	g.ship.(*custom.RectangleNode).SetColor(rendering.NewPaletteInt64(rendering.Orange))
+	g.ship.SetScale(40.0)
+
+	g.orbit = animation.NewAngularMotion()
+	g.orbit.SetRate(maths.DegreeToRadians * 20.0)
+
+	// Main view follows the ship softly and stays within the board.
+	main := g.camera.Camera(0)
+	main.SetSmoothing(0.05)
+	main.SetDeadZone(100.0, 100.0)
+	main.SetBounds(-vw, -vh, vw, vh)
+	g.camera.Follow(0, g.ship)
+
+	// A minimap in the upper-right corner shows the whole board.
+	ww := world.WindowSize().X()
+	mini := g.camera.AddViewport(ww-320.0, 20.0, 300.0, 170.0)
+	g.camera.Camera(mini).SetZoom(0.1)
+
+	text := custom.NewRasterTextNode("Help", world, g)
+	tr := text.(*custom.RasterTextNode)
+	tr.SetText("s = shake, z/x = zoom")
+	tr.SetFontScale(2)
+	tr.SetFill(1)
+	tr.SetPosition(15.0, 50.0) // Note these coords are in device-space
+	tr.SetColor(rendering.NewPaletteInt64(rendering.White))
+}
+
+// Update updates the time properties of a node.
+func (g *gameLayer) Update(msPerUpdate, secPerUpdate float64) {
+	g.orbit.Update(msPerUpdate)
+}
+
+// Interpolate is used for blending time based properties.
+func (g *gameLayer) Interpolate(interpolation float64) {
+	angle := g.orbit.Interpolate(interpolation).(float64)
+	g.ship.SetPosition(math.Cos(angle)*500.0, math.Sin(angle)*300.0)
+	g.ship.SetRotation(angle)
+}
+
+// -----------------------------------------------------
+// Node lifecycles
+// -----------------------------------------------------
+
+// EnterNode called when a node is entering the stage
+func (g *gameLayer) EnterNode(man api.INodeManager) {
+	man.RegisterTarget(g)
+	man.RegisterEventTarget(g)
+}
+
+// ExitNode called when a node is exiting stage
+func (g *gameLayer) ExitNode(man api.INodeManager) {
+	man.UnRegisterTarget(g)
+	man.UnRegisterEventTarget(g)
+}
+
+// -----------------------------------------------------
+// IO events
+// -----------------------------------------------------
+
+func (g *gameLayer) Handle(event api.IEvent) bool {
+	if event.GetType() == api.IOTypeKeyboard && event.GetState() == 1 {
+		main := g.camera.Camera(0)
+
+		switch event.GetKeyCode() {
+		case 115: // s = shake
+			main.Shake(15.0, 500.0)
+		case 122: // z = zoom in
+			main.SetZoom(main.Zoom() * 1.25)
+		case 120: // x = zoom out
+			main.SetZoom(main.Zoom() / 1.25)
+		}
+	}
+
+	return false
+}
@@ -24,7 +24,10 @@ type gameLayer struct {
 
 	crossNode api.INode
 
-	rectNode api.INode
+	rectNode  api.INode
+	greenNode api.INode
+	// dragged is the rectangle under the mouse when the button went down
+	dragged api.INode
 
 	// Motion is for rotating cube
 	angularMotion api.IMotion
@@ -46,6 +49,8 @@ func (g *gameLayer) Build(world api.IWorld) {
 	g.Node.Build(world)
 
 	g.drag = misc.NewDragState()
+	// The dragged rectangle comes to the top
+	g.drag.SetRaiseOnDrag(true)
 
 	vw, vh := world.ViewSize().Components()
 	x := -vw / 2.0
@@ -72,12 +77,20 @@ func (g *gameLayer) Build(world api.IWorld) {
 	// g.rectNode.SetRotation(maths.DegreeToRadians * 35.0)
 	g.rectNode.SetPosition(100.0, -150.0)
 
+	g.greenNode = custom.NewRectangleNode("Green Rect", world, g)
+	gn := g.greenNode.(*custom.RectangleNode)
+	gn.SetColor(rendering.NewPaletteInt64(rendering.SoftGreen))
+	g.greenNode.SetScale(100.0)
+	g.greenNode.SetPosition(150.0, -100.0)
+
 	g.angularMotion = animation.NewAngularMotion()
 	// amgle is measured in angular-velocity or "degrees/second"
 	g.angularMotion.SetRate(maths.DegreeToRadians * 90.0)
 
 	g.crossNode = custom.NewCrossNode("Cross", world, g)
 	g.crossNode.SetScale(30.0)
+	// The cross stays above the rectangles even as they are raised.
+	g.crossNode.SetDrawLayer(api.DrawLayerHUD)
 }
 
 // Update updates the time properties of a node.
@@ -134,20 +147,45 @@ func (g *gameLayer) Handle(event api.IEvent) bool {
 		// Passing "g" would cause SetMotion...() to use g's parent which
 		// is SplashScene verses rectangle node's parent which is GameLayer.
 		// However, to be explicit I pass "g.rectNode"
-		g.drag.SetMotionStateUsing(mx, my, event.GetState(), g.rectNode)
+		if g.dragged == nil {
+			return false
+		}
 
-		rn := g.rectNode.(*custom.RectangleNode)
+		g.drag.SetMotionStateUsing(mx, my, event.GetState(), g.dragged)
 
-		if g.drag.IsDragging() && rn.PointInside() {
-			pos := g.rectNode.Position()
-			g.rectNode.SetPosition(pos.X()+g.drag.Delta().X(), pos.Y()+g.drag.Delta().Y())
+		if g.drag.IsDragging() {
+			pos := g.dragged.Position()
+			g.dragged.SetPosition(pos.X()+g.drag.Delta().X(), pos.Y()+g.drag.Delta().Y())
 		}
 
-	} else if event.GetType() == api.IOTypeMouseButtonDown || event.GetType() == api.IOTypeMouseButtonUp {
-		mx, my := event.GetMousePosition()
-		// On mouse events if state = 1 then dragging
-		g.drag.SetButtonStateUsing(mx, my, event.GetButton(), event.GetState(), g.rectNode)
+	} else if event.GetType() == api.IOTypeMouseButtonDown {
+		g.dragged = g.rectangleUnderMouse()
+		if g.dragged != nil {
+			mx, my := event.GetMousePosition()
+			// On mouse events if state = 1 then dragging
+			g.drag.SetButtonStateUsing(mx, my, event.GetButton(), event.GetState(), g.dragged)
+		}
+	} else if event.GetType() == api.IOTypeMouseButtonUp {
+		if g.dragged != nil {
+			mx, my := event.GetMousePosition()
+			g.drag.SetButtonStateUsing(mx, my, event.GetButton(), event.GetState(), g.dragged)
+			g.dragged = nil
+		}
 	}
 
 	return false
 }
+
+// rectangleUnderMouse returns the top most rectangle under the mouse.
+// Children are ordered by draw layer and z-index, so the last is on top.
+func (g *gameLayer) rectangleUnderMouse() api.INode {
+	children := g.Children()
+
+	for i := len(children) - 1; i >= 0; i-- {
+		if rn, ok := children[i].(*custom.RectangleNode); ok && rn.PointInside() {
+			return rn
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"math"
+
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine/animation"
+	"github.com/wdevore/RangerGo/engine/maths"
+	"github.com/wdevore/RangerGo/engine/nodes"
+	"github.com/wdevore/RangerGo/engine/nodes/custom"
+	"github.com/wdevore/RangerGo/engine/rendering"
+)
+
+type gameLayer struct {
+	nodes.Node
+
+	// The background is static so it is rendered once and cached.
+	background *custom.LayerNode
+	// The foreground fades in and out.
+	foreground *custom.LayerNode
+
+	square api.INode
+
+	spin api.IMotion
+	fade float64
+
+	blend  int
+	tinted bool
+}
+
+func newBasicGameLayer(name string, parent api.INode) api.INode {
+	o := new(gameLayer)
+	o.Initialize(name)
+	o.SetParent(parent)
+	parent.AddChild(o)
+	return o
+}
+
+func (g *gameLayer) Build(world api.IWorld) {
+	g.Node.Build(world)
+
+	g.background = custom.NewLayerNode("Background", world, g).(*custom.LayerNode)
+	g.background.SetCached(true)
+
+	board := custom.NewCheckBoardNode("CheckBoard", world, g.background)
+	board.(*custom.CheckerBoardNode).Configure(25.0)
+
+	g.foreground = custom.NewLayerNode("Foreground", world, g).(*custom.LayerNode)
+
+	g.square = custom.NewRectangleNode("Orange Rect", world, g.foreground)
+	g.square.(*custom.RectangleNode).SetColor(rendering.NewPaletteInt64(rendering.Orange))
+	g.square.SetScale(200.0)
+
+	inner := custom.NewRectangleNode("Green Rect", world, g.square)
+	inner.(*custom.RectangleNode).SetColor(rendering.NewPaletteInt64(rendering.SoftGreen))
+	inner.SetScale(0.5)
+	inner.SetPosition(0.5, 0.5)
+
+	g.spin = animation.NewAngularMotion()
+	g.spin.SetRate(maths.DegreeToRadians * 45.0)
+
+	text := custom.NewRasterTextNode("Help", world, g)
+	tr := text.(*custom.RasterTextNode)
+	tr.SetText("b = blend mode, t = tint")
+	tr.SetFontScale(2)
+	tr.SetFill(1)
+	tr.SetPosition(15.0, 50.0) // Note these coords are in device-space
+	tr.SetColor(rendering.NewPaletteInt64(rendering.White))
+}
+
+// Update updates the time properties of a node.
+func (g *gameLayer) Update(msPerUpdate, secPerUpdate float64) {
+	g.spin.Update(msPerUpdate)
+	g.fade += secPerUpdate
+}
+
+// Interpolate is used for blending time based properties.
+func (g *gameLayer) Interpolate(interpolation float64) {
+	g.square.SetRotation(g.spin.Interpolate(interpolation).(float64))
+
+	// The whole foreground, including overlapping children, fades as one.
+	g.foreground.SetOpacity(0.5 + math.Sin(g.fade)*0.5)
+}
+
+// -----------------------------------------------------
+// Node lifecycles
+// -----------------------------------------------------
+
+// EnterNode called when a node is entering the stage
+func (g *gameLayer) EnterNode(man api.INodeManager) {
+	man.RegisterTarget(g)
+	man.RegisterEventTarget(g)
+}
+
+// ExitNode called when a node is exiting stage
+func (g *gameLayer) ExitNode(man api.INodeManager) {
+	man.UnRegisterTarget(g)
+	man.UnRegisterEventTarget(g)
+}
+
+// -----------------------------------------------------
+// IO events
+// -----------------------------------------------------
+
+func (g *gameLayer) Handle(event api.IEvent) bool {
+	if event.GetType() == api.IOTypeKeyboard && event.GetState() == 1 {
+		switch event.GetKeyCode() {
+		case 98: // b = cycle blend modes
+			g.blend = (g.blend + 1) % (api.BlendNone + 1)
+			g.foreground.SetBlendMode(g.blend)
+		case 116: // t = toggle tint
+			g.tinted = !g.tinted
+			if g.tinted {
+				g.foreground.SetTint(rendering.NewPaletteInt64(rendering.SoftBlue))
+			} else {
+				g.foreground.SetTint(rendering.NewPaletteInt64(rendering.White))
+			}
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,145 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/veandco/go-sdl2/sdl"
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine/nodes"
+	"github.com/wdevore/RangerGo/engine/nodes/custom"
+	"github.com/wdevore/RangerGo/engine/rendering"
+)
+
+const (
+	rows    = 20
+	columns = 30
+	// textureSize is the width and height of the generated texture
+	textureSize = 128
+)
+
+type gameLayer struct {
+	nodes.Node
+
+	loader api.ILoader
+
+	image *imageNode
+}
+
+func newBasicGameLayer(name string, parent api.INode) api.INode {
+	o := new(gameLayer)
+	o.Initialize(name)
+	o.SetParent(parent)
+	parent.AddChild(o)
+	return o
+}
+
+// Build runs on the loader's goroutine. The sleeps stand in for
+// expensive work, for example, parsing level files.
+func (g *gameLayer) Build(world api.IWorld) {
+	g.Node.Build(world)
+
+	vw, vh := world.ViewSize().Components()
+	w := vw / columns
+	h := vh / rows
+
+	colors := []uint64{rendering.SoftBlue, rendering.SoftGreen, rendering.Navy}
+
+	for r := 0; r < rows; r++ {
+		for c := 0; c < columns; c++ {
+			cell := custom.NewRectangleNode(fmt.Sprintf("Cell %d,%d", r, c), world, g)
+			cell.(*custom.RectangleNode).SetColor(rendering.NewPaletteInt64(colors[(r+c)%len(colors)]))
+			cell.SetScale(w * 0.8)
+			cell.SetPosition(-vw/2.0+w*(float64(c)+0.5), -vh/2.0+h*(float64(r)+0.5))
+		}
+
+		// Give up if the loading scene has gone.
+		if g.loader.IsStopped() {
+			return
+		}
+
+		time.Sleep(100 * time.Millisecond)
+		// The texture is the last 10%.
+		g.loader.SetProgress(float64(r+1) / rows * 0.9)
+	}
+
+	g.image = newImageNode("Image", g)
+	g.image.Build(world)
+	g.image.SetScale(400.0)
+
+	// SDL must only be used on the render thread.
+	pixels := generatePixels()
+	g.loader.Call(func() {
+		texture, err := world.Renderer().CreateTexture(
+			sdl.PIXELFORMAT_RGBA8888, sdl.TEXTUREACCESS_STATIC, textureSize, textureSize)
+		if err != nil {
+			fmt.Println("Unable to create texture: ", err)
+			return
+		}
+		texture.Update(nil, pixels, textureSize*4)
+		texture.SetBlendMode(sdl.BLENDMODE_BLEND)
+		g.image.texture = texture
+	})
+
+	g.loader.SetProgress(1.0)
+}
+
+// generatePixels creates a radial gradient
+func generatePixels() []byte {
+	pixels := make([]byte, textureSize*textureSize*4)
+	half := textureSize / 2
+
+	for y := 0; y < textureSize; y++ {
+		for x := 0; x < textureSize; x++ {
+			dx, dy := x-half, y-half
+			d := 255 - (dx*dx+dy*dy)*255/(half*half)
+			if d < 0 {
+				d = 0
+			}
+
+			// RGBA8888 is stored as ABGR bytes on little endian machines.
+			i := (y*textureSize + x) * 4
+			pixels[i] = byte(d) // A
+			pixels[i+1] = 0x00  // B
+			pixels[i+2] = 0x7f  // G
+			pixels[i+3] = 0xff  // R
+		}
+	}
+
+	return pixels
+}
+
+// imageNode draws a texture centered on its origin
+type imageNode struct {
+	nodes.Node
+
+	texture *sdl.Texture
+	tint    api.IPalette
+}
+
+func newImageNode(name string, parent api.INode) *imageNode {
+	o := new(imageNode)
+	o.Initialize(name)
+	o.SetParent(parent)
+	parent.AddChild(o)
+	o.tint = rendering.NewPaletteInt64(rendering.White)
+	return o
+}
+
+// ExitNode called when a node is exiting stage
+func (i *imageNode) ExitNode(man api.INodeManager) {
+	if i.texture != nil {
+		i.texture.Destroy()
+		i.texture = nil
+	}
+}
+
+// Draw renders the texture
+func (i *imageNode) Draw(context api.IRenderContext) {
+	if i.texture == nil {
+		return
+	}
+
+	context.SetDrawColor(i.tint)
+	context.RenderTexture(i.texture, -0.5, -0.5, 1.0, 1.0)
+}
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine"
+	"github.com/wdevore/RangerGo/engine/misc"
+	"github.com/wdevore/RangerGo/engine/nodes/custom"
+)
+
+var ranger api.IEngine
+
+func init() {
+}
+
+func main() {
+	world := engine.NewWorld("Loading", 1.5, "..")
+
+	ranger = engine.New(world)
+
+	// The splash scene is built in the background while the loading
+	// scene shows the progress.
+	loader := misc.NewLoader(world, buildSplashScene)
+
+	loading := custom.NewLoadingScene("Loading", world, loader)
+	// Show the loading scene for at least a second.
+	loading.(api.ITransition).SetPauseTime(1000.0)
+
+	boot := custom.NewBasicBootScene("Boot", loading)
+
+	ranger.PushStart(boot)
+
+	ranger.Configure()
+
+	ranger.Start()
+
+	ranger.End()
+}
+
+// buildSplashScene runs on the loader's goroutine
+func buildSplashScene(world api.IWorld, loader api.ILoader) api.INode {
+	splash := newBasicSplashScene("Splash", nil)
+	splash.(*sceneSplash).loader = loader
+	splash.Build(world)
+	return splash
+}
@@ -0,0 +1,81 @@
+package main
+
+import (
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine/animation"
+	"github.com/wdevore/RangerGo/engine/maths"
+	"github.com/wdevore/RangerGo/engine/nodes"
+	"github.com/wdevore/RangerGo/engine/nodes/custom"
+	"github.com/wdevore/RangerGo/engine/rendering"
+)
+
+type gameLayer struct {
+	nodes.Node
+
+	circle api.INode
+
+	drift api.IMotion
+	start api.IVector
+}
+
+func newBasicGameLayer(name string, parent api.INode) api.INode {
+	o := new(gameLayer)
+	o.Initialize(name)
+	o.SetParent(parent)
+	parent.AddChild(o)
+	return o
+}
+
+func (g *gameLayer) Build(world api.IWorld) {
+	g.Node.Build(world)
+
+	square := custom.NewRectangleNode("Orange Rect", world, g)
+	sq := square.(*custom.RectangleNode)
+	sq.SetColor(rendering.NewPaletteInt64(rendering.Orange))
+	square.SetScale(100.0)
+	square.SetPosition(-200.0, 0.0)
+
+	// Spins at 90 degrees/second
+	spin := animation.NewAngularMotion()
+	spin.SetRate(maths.DegreeToRadians * 90.0)
+	square.AddMotion(spin, animation.ApplyRotation(square))
+
+	// Fades from orange to blue every 2 seconds, then starts over
+	fade := animation.NewColorMotion()
+	fade.SetRate(0.5)
+	fade.Set(rendering.NewPaletteInt64(rendering.Orange), rendering.NewPaletteInt64(rendering.SoftBlue))
+	square.AddMotion(fade, animation.ApplyColor(sq))
+
+	g.circle = custom.NewCircleNode("Circle", world, g)
+	g.circle.(*custom.CircleNode).SetColor(rendering.NewPaletteInt64(rendering.SoftGreen))
+	g.circle.SetScale(50.0)
+
+	// Drifts up and to the right at 100 pixels/second
+	g.start = maths.NewVectorUsing(100.0, 200.0)
+	g.drift = animation.NewLinear2DMotion()
+	g.drift.SetRate(100.0)
+	g.drift.(*animation.Linear2DMotion).SetDirection(1.0, -1.0)
+	g.drift.Set(g.start, g.start)
+	g.circle.AddMotion(g.drift, animation.ApplyPosition(g.circle))
+}
+
+// Update restarts the circle's drift once it has gone far enough.
+func (g *gameLayer) Update(msPerUpdate, secPerUpdate float64) {
+	if g.circle.Position().Y() < -200.0 {
+		g.drift.Set(g.start, g.start)
+	}
+}
+
+// -----------------------------------------------------
+// Node lifecycles
+// -----------------------------------------------------
+
+// EnterNode called when a node is entering the stage
+func (g *gameLayer) EnterNode(man api.INodeManager) {
+	man.RegisterTarget(g)
+}
+
+// ExitNode called when a node is exiting stage
+func (g *gameLayer) ExitNode(man api.INodeManager) {
+	man.UnRegisterTarget(g)
+}
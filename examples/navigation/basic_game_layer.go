@@ -0,0 +1,127 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine/ai/navigation"
+	"github.com/wdevore/RangerGo/engine/animation/actions"
+	"github.com/wdevore/RangerGo/engine/geometry"
+	"github.com/wdevore/RangerGo/engine/nodes"
+	"github.com/wdevore/RangerGo/engine/nodes/custom"
+	"github.com/wdevore/RangerGo/engine/rendering"
+)
+
+type gameLayer struct {
+	nodes.Node
+
+	cursorPosition api.IPoint
+
+	mesh     *navigation.NavMesh
+	meshNode *custom.NavigationNode
+	unit     api.INode
+	runner   api.IActionRunner
+}
+
+func newBasicGameLayer(name string, parent api.INode) api.INode {
+	o := new(gameLayer)
+	o.Initialize(name)
+	o.SetParent(parent)
+	parent.AddChild(o)
+	return o
+}
+
+func (g *gameLayer) Build(world api.IWorld) {
+	g.Node.Build(world)
+
+	g.cursorPosition = geometry.NewPoint()
+
+	vw, vh := world.ViewSize().Components()
+	w, h := vw/2.0-20.0, vh/2.0-20.0
+
+	// Fences, drawn and used as obstacles
+	fences := [][]float64{
+		{-400.0, -300.0, -380.0, -300.0, -380.0, 200.0, -400.0, 200.0},
+		{-200.0, -100.0, 200.0, -100.0, 200.0, -80.0, -200.0, -80.0},
+		{0.0, 50.0, 20.0, 50.0, 20.0, 350.0, 0.0, 350.0},
+		{300.0, -350.0, 450.0, -200.0, 430.0, -180.0, 280.0, -330.0},
+		{250.0, 150.0, 500.0, 150.0, 500.0, 170.0, 250.0, 170.0},
+	}
+
+	obstacles := []api.IPolygon{}
+	for i, f := range fences {
+		n := custom.NewPolygonNode(fmt.Sprintf("Fence %d", i), world, g)
+		fence := n.(*custom.PolygonNode)
+		fence.SetColor(rendering.NewPaletteInt64(rendering.LightGray))
+		fence.EnableHitDetection(false)
+		for v := 0; v < len(f); v += 2 {
+			fence.AddVertex(f[v], f[v+1], v+2 == len(f))
+		}
+		obstacles = append(obstacles, fence.Polygon())
+	}
+
+	boundary := geometry.NewPolygon()
+	boundary.AddVertex(-w, -h)
+	boundary.AddVertex(w, -h)
+	boundary.AddVertex(w, h)
+	boundary.AddVertex(-w, h)
+	boundary.Build()
+
+	var err error
+	g.mesh, err = navigation.NewNavMesh(boundary, obstacles...)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+
+	n := custom.NewNavigationNode("NavMesh", world, g)
+	g.meshNode = n.(*custom.NavigationNode)
+	g.meshNode.SetNavigator(g.mesh)
+
+	g.unit = custom.NewCircleNode("Unit", world, g)
+	g.unit.(*custom.CircleNode).SetColor(rendering.NewPaletteInt64(rendering.Orange))
+	g.unit.SetScale(10.0)
+	g.unit.SetPosition(-450.0, 0.0)
+}
+
+// -----------------------------------------------------
+// Node lifecycles
+// -----------------------------------------------------
+
+// EnterNode called when a node is entering the stage
+func (g *gameLayer) EnterNode(man api.INodeManager) {
+	g.runner = man.Actions()
+	man.RegisterEventTarget(g)
+}
+
+// ExitNode called when a node is exiting stage
+func (g *gameLayer) ExitNode(man api.INodeManager) {
+	man.UnRegisterEventTarget(g)
+}
+
+// -----------------------------------------------------
+// IO events
+// -----------------------------------------------------
+
+// Handle routes the unit to where the mouse is clicked
+func (g *gameLayer) Handle(event api.IEvent) bool {
+	if event.GetType() != api.IOTypeMouseButtonDown || g.mesh == nil {
+		return false
+	}
+
+	mx, my := event.GetMousePosition()
+	nodes.MapDeviceToView(g.World(), mx, my, g.cursorPosition)
+
+	from := g.unit.Position()
+	points, found := g.mesh.FindPath(from.X(), from.Y(), g.cursorPosition.X(), g.cursorPosition.Y())
+	if !found {
+		return false
+	}
+
+	g.meshNode.SetPath(points)
+
+	g.runner.Stop(g.unit)
+	g.runner.Run(g.unit, actions.FollowPath(navigation.ToPath(points), 250.0, false))
+
+	return true
+}
@@ -94,7 +94,9 @@ func (g *gameLayer) Build(world api.IWorld) {
 	for i := 0; i < 50; i++ {
 		v := NewTriangleNode(fmt.Sprintf("Tri%d", i), g)
 		v.Build(world)
-		v.SetColor(rendering.NewPaletteInt64(rendering.Black))
+		v.SetColor(rendering.NewPaletteInt64(rendering.Orange))
+		// Overlapping particles brighten
+		v.SetBlendMode(api.BlendAdditive)
 		v.SetVisible(false)
 		v.SetScale(10.0)
 		p := particles.NewParticle(v)
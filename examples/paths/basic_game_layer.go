@@ -0,0 +1,95 @@
+package main
+
+import (
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine/animation/actions"
+	"github.com/wdevore/RangerGo/engine/animation/tweening"
+	"github.com/wdevore/RangerGo/engine/geometry"
+	"github.com/wdevore/RangerGo/engine/nodes"
+	"github.com/wdevore/RangerGo/engine/nodes/custom"
+	"github.com/wdevore/RangerGo/engine/rendering"
+)
+
+type gameLayer struct {
+	nodes.Node
+
+	patrol api.IPath
+	rail   api.IPath
+
+	enemy api.INode
+	rider api.INode
+}
+
+func newBasicGameLayer(name string, parent api.INode) api.INode {
+	o := new(gameLayer)
+	o.Initialize(name)
+	o.SetParent(parent)
+	parent.AddChild(o)
+	return o
+}
+
+func (g *gameLayer) Build(world api.IWorld) {
+	g.Node.Build(world)
+
+	// A closed patrol route through its points
+	g.patrol = geometry.NewCatmullRomPath()
+	g.patrol.AddPoint(-400.0, -200.0)
+	g.patrol.AddPoint(-100.0, -250.0)
+	g.patrol.AddPoint(-50.0, 0.0)
+	g.patrol.AddPoint(-250.0, 200.0)
+	g.patrol.AddPoint(-450.0, 50.0)
+	g.patrol.SetClosed(true)
+	g.patrol.Build()
+
+	// A rail of two Bezier segments
+	g.rail = geometry.NewBezierPath()
+	g.rail.AddPoint(100.0, 250.0)
+	g.rail.AddPoint(100.0, 0.0)
+	g.rail.AddPoint(250.0, -300.0)
+	g.rail.AddPoint(300.0, -50.0)
+	g.rail.AddPoint(350.0, 200.0)
+	g.rail.AddPoint(500.0, 100.0)
+	g.rail.AddPoint(500.0, -250.0)
+	g.rail.Build()
+
+	n := custom.NewPathNode("Patrol", world, g)
+	n.(*custom.PathNode).SetPath(g.patrol)
+	n.(*custom.PathNode).ShowPoints(true)
+
+	n = custom.NewPathNode("Rail", world, g)
+	n.(*custom.PathNode).SetColor(rendering.NewPaletteInt64(rendering.SoftBlue))
+	n.(*custom.PathNode).SetPath(g.rail)
+	n.(*custom.PathNode).ShowPoints(true)
+
+	g.enemy = arrow(world, g, rendering.Red)
+	g.rider = arrow(world, g, rendering.SoftGreen)
+}
+
+// arrow makes a polygon pointing along its x-axis.
+func arrow(world api.IWorld, parent api.INode, color uint64) api.INode {
+	n := custom.NewPolygonNode("Arrow", world, parent)
+	poly := n.(*custom.PolygonNode)
+	poly.SetColor(rendering.NewPaletteInt64(color))
+	poly.EnableHitDetection(false)
+	poly.AddVertex(15.0, 0.0, false)
+	poly.AddVertex(-10.0, 10.0, false)
+	poly.AddVertex(-10.0, -10.0, true)
+	return n
+}
+
+// -----------------------------------------------------
+// Node lifecycles
+// -----------------------------------------------------
+
+// EnterNode called when a node is entering the stage
+func (g *gameLayer) EnterNode(man api.INodeManager) {
+	// The enemy patrols forever at 150 units/second.
+	man.Actions().Run(g.enemy, actions.RepeatForever(actions.FollowPath(g.patrol, 150.0, true)))
+
+	// The rider eases along the rail, pauses and starts over.
+	ride := actions.Ease(tweening.NewEquation(api.EquationSine, api.EaseInOut), actions.FollowPath(g.rail, 200.0, true))
+	man.Actions().Run(g.rider, actions.RepeatForever(actions.Sequence(
+		ride,
+		actions.Delay(1000.0),
+	)))
+}
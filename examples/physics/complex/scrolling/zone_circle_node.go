@@ -3,9 +3,8 @@ package main
 import (
 	"math"
 
-	"github.com/tanema/gween"
-	"github.com/tanema/gween/ease"
 	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine/animation/tweening"
 	"github.com/wdevore/RangerGo/engine/geometry"
 	"github.com/wdevore/RangerGo/engine/misc"
 	"github.com/wdevore/RangerGo/engine/nodes"
@@ -32,10 +31,10 @@ type ZoneCircleNode struct {
 	zoneState int
 
 	tweenEnabled      bool
-	tweenZoomIn       *gween.Tween
-	tweenZoomOut      *gween.Tween
+	tweenZoomIn       api.ITween
+	tweenZoomOut      api.ITween
 	isFinished        bool
-	tweenCurrentValue float32
+	tweenCurrentValue float64
 
 	zoomTo   float64
 	zoomFrom float64
@@ -152,7 +151,7 @@ func (z *ZoneCircleNode) UpdateCheck(point api.IPoint) (state, id int) {
 		// Send message to listeners. The "id" is a self identifier.
 		// Most likely the ZoneManager
 		for _, listener := range z.subscribers {
-			listener.Notify(z.zoneState, id)
+			listener.Notify(z.zoneState, id, objectStarShip)
 		}
 
 		z.createTween(z.zoneState, id)
@@ -166,13 +165,13 @@ func (z *ZoneCircleNode) TweenUpdate(msPerUpdate float64) (float64, bool) {
 	if z.tweenEnabled {
 		switch z.zoneState {
 		case api.CrossStateEntered:
-			z.tweenCurrentValue, z.isFinished = z.tweenZoomIn.Update(float32(msPerUpdate))
+			z.tweenCurrentValue, z.isFinished = z.tweenZoomIn.Update(msPerUpdate)
 			if z.isFinished {
 				z.tweenEnabled = false
 				z.zoneMan.SetAnimationActive(false)
 			}
 		case api.CrossStateExited:
-			z.tweenCurrentValue, z.isFinished = z.tweenZoomOut.Update(float32(msPerUpdate))
+			z.tweenCurrentValue, z.isFinished = z.tweenZoomOut.Update(msPerUpdate)
 			if z.isFinished {
 				z.tweenEnabled = false
 				z.zoneMan.SetAnimationActive(false)
@@ -180,7 +179,7 @@ func (z *ZoneCircleNode) TweenUpdate(msPerUpdate float64) (float64, bool) {
 		}
 	}
 
-	return float64(z.tweenCurrentValue), z.isFinished
+	return z.tweenCurrentValue, z.isFinished
 }
 
 // ----------------------------------------------------------
@@ -200,12 +199,12 @@ func (z *ZoneCircleNode) createTween(state, id int) {
 	switch state {
 	case api.CrossStateEntered:
 		if !z.isFinished {
-			z.tweenZoomIn = gween.New(float32(z.zoneMan.ZoomScale()), float32(z.zoomTo), float32(z.duration), ease.InOutQuad)
+			z.tweenZoomIn = tweening.NewTween(z.zoneMan.ZoomScale(), z.zoomTo, z.duration, api.EquationQuad, api.EaseInOut)
 		} else {
 			if z.zoneMan.AnimationActive() {
-				z.tweenZoomIn = gween.New(float32(z.zoneMan.ZoomScale()), float32(z.zoomTo), float32(z.duration), ease.InOutQuad)
+				z.tweenZoomIn = tweening.NewTween(z.zoneMan.ZoomScale(), z.zoomTo, z.duration, api.EquationQuad, api.EaseInOut)
 			} else {
-				z.tweenZoomIn = gween.New(float32(z.zoomFrom), float32(z.zoomTo), float32(z.duration), ease.InOutQuad)
+				z.tweenZoomIn = tweening.NewTween(z.zoomFrom, z.zoomTo, z.duration, api.EquationQuad, api.EaseInOut)
 			}
 		}
 		z.innerColor = rendering.NewPaletteInt64(rendering.Lime)
@@ -213,12 +212,12 @@ func (z *ZoneCircleNode) createTween(state, id int) {
 		z.zoneMan.SetAnimationActive(true)
 	case api.CrossStateExited:
 		if !z.isFinished {
-			z.tweenZoomOut = gween.New(float32(z.zoneMan.ZoomScale()), float32(z.zoomFrom), float32(z.duration), ease.InOutQuad)
+			z.tweenZoomOut = tweening.NewTween(z.zoneMan.ZoomScale(), z.zoomFrom, z.duration, api.EquationQuad, api.EaseInOut)
 		} else {
 			if z.zoneMan.AnimationActive() {
-				z.tweenZoomOut = gween.New(float32(z.zoneMan.ZoomScale()), float32(z.zoomFrom), float32(z.duration), ease.InOutQuad)
+				z.tweenZoomOut = tweening.NewTween(z.zoneMan.ZoomScale(), z.zoomFrom, z.duration, api.EquationQuad, api.EaseInOut)
 			} else {
-				z.tweenZoomOut = gween.New(float32(z.zoomTo), float32(z.zoomFrom), float32(z.duration), ease.InOutQuad)
+				z.tweenZoomOut = tweening.NewTween(z.zoomTo, z.zoomFrom, z.duration, api.EquationQuad, api.EaseInOut)
 			}
 		}
 		z.innerColor = rendering.NewPaletteInt64(rendering.LightGray)
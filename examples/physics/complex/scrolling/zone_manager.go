@@ -2,16 +2,19 @@ package main
 
 import (
 	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine/nodes"
 	"github.com/wdevore/RangerGo/engine/nodes/custom"
 )
 
+// zoneTag marks the zone nodes
+const zoneTag = "zone"
+
 // zoneManager handles zones
 // The ZM coordinates between zones and any animations created by them.
 // When a zone is entered all other zones' animations must stop
 type zoneManager struct {
 	parent api.INode
 
-	zones         []api.INode
 	enteredZoneID int
 
 	// Zooming
@@ -35,7 +38,7 @@ func (z *zoneManager) Build(world api.IWorld) {
 	gz.SetStepSize(0.05)
 
 	zone := NewZoneCircleNode("RightCircleZone", z.parent.World(), z.zoom, z)
-	z.zones = append(z.zones, zone)
+	zone.AddTag(zoneTag)
 	zone.SetID(objectRightZone)
 	gr := zone.(*ZoneCircleNode)
 	gr.SetTweenRange(1.0, 3.0)
@@ -45,7 +48,7 @@ func (z *zoneManager) Build(world api.IWorld) {
 	gr.SetPosition(30.0, 20.0)
 
 	zone = NewZoneCircleNode("LeftCircleZone", z.parent.World(), z.zoom, z)
-	z.zones = append(z.zones, zone)
+	zone.AddTag(zoneTag)
 	zone.SetID(objectLeftZone)
 	gr = zone.(*ZoneCircleNode)
 	gr.SetTweenRange(1.0, 2.0)
@@ -64,7 +67,7 @@ func (z *zoneManager) GetZoom() api.INode {
 func (z *zoneManager) UpdateCheck(point api.IPoint, msPerUpdate float64) {
 	isFinished := true
 
-	for _, zone := range z.zones {
+	for _, zone := range nodes.FindByTag(z.zoom, zoneTag) {
 		grz := zone.(*ZoneCircleNode)
 		grz.UpdateCheck(point)
 
@@ -99,21 +102,18 @@ func (z *zoneManager) ZoomScale() float64 {
 // ----------------------------------------------------------
 
 // Notify receives messages from IZone objects
-func (z *zoneManager) Notify(state, id int) {
+func (z *zoneManager) Notify(state, zoneID, objectID int) {
 	if state != api.CrossStateEntered {
 		return
 	}
 
-	z.enteredZoneID = id
+	z.enteredZoneID = zoneID
 
 	// fmt.Println("ZM notified: ", z.enteredZoneID)
 
 	// Find zone that matches "id"
-	for _, zone := range z.zones {
-		if z.enteredZoneID == zone.ID() {
-			gz := z.zoom.(*custom.ZoomNode)
-			gz.SetFocalPoint(zone.Position().X(), zone.Position().Y())
-			break
-		}
+	if zone := z.zoom.FindByID(z.enteredZoneID); zone != nil {
+		gz := z.zoom.(*custom.ZoomNode)
+		gz.SetFocalPoint(zone.Position().X(), zone.Position().Y())
 	}
 }
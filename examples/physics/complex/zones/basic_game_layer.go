@@ -23,6 +23,7 @@ const (
 const (
 	objectRightZone = 2000
 	objectLeftZone  = 2001
+	objectStarShip  = 2002
 )
 
 type gameLayer struct {
@@ -1,25 +1,32 @@
 package main
 
 import (
+	"log"
+
 	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine/animation/timeline"
+	"github.com/wdevore/RangerGo/engine/misc"
 	"github.com/wdevore/RangerGo/engine/nodes/custom"
 )
 
+// zoneTimelines holds each zone's "<zone>.enter" and "<zone>.exit"
+// choreography.
+const zoneTimelines = "/assets/zone_timelines.json"
+
 // zoneManager handles zones
 // The ZM coordinates between zones and any animations created by them.
-// When a zone is entered all other zones' animations must stop
+// When a zone is entered or exited its timeline replaces any playing
+// timeline.
 type zoneManager struct {
 	parent api.INode
 
-	zones         []api.INode
-	enteredZoneID int
-
 	// Zooming
 	zoom api.INode
 
-	zoomScale float64
+	zones *misc.ZoneManager
 
-	animationActive bool
+	timelines map[string]*timeline.Timeline
+	playing   api.ITimeline
 }
 
 // newZoneManager creates a zone manager
@@ -34,26 +41,42 @@ func (z *zoneManager) Build(world api.IWorld) {
 	gz := z.zoom.(*custom.ZoomNode)
 	gz.SetStepSize(0.05)
 
-	zone := NewZoneCircleNode("RightCircleZone", z.parent.World(), z.zoom, z)
-	z.zones = append(z.zones, zone)
+	z.zones = misc.NewZoneManager()
+	z.zones.Subscribe(z)
+
+	zone := NewZoneCircleNode("RightCircleZone", z.parent.World(), z.zoom)
 	zone.SetID(objectRightZone)
 	gr := zone.(*ZoneCircleNode)
-	gr.SetTweenRange(1.0, 3.0)
-	gr.SetTweenDuration(1000.0)
 	gr.Configure(12, 13.0, 15.0)
-	gr.RequestNotification(z)
 	gr.SetPosition(30.0, 20.0)
+	z.zones.AddZone(zone.ID(), gr.Zone())
 
-	zone = NewZoneCircleNode("LeftCircleZone", z.parent.World(), z.zoom, z)
-	z.zones = append(z.zones, zone)
+	zone = NewZoneCircleNode("LeftCircleZone", z.parent.World(), z.zoom)
 	zone.SetID(objectLeftZone)
 	gr = zone.(*ZoneCircleNode)
-	gr.SetTweenRange(1.0, 2.0)
-	gr.SetTweenDuration(1000.0)
 	gr.Configure(12, 7.0, 10.0)
-	gr.RequestNotification(z)
 	gr.SetPosition(-30.0, 20.0)
 	// gr.SetPosition(0.0, 15.0)
+	z.zones.AddZone(zone.ID(), gr.Zone())
+
+	// Timelines animate the ZoomNode's scale as "zoom".
+	timeline.RegisterProperty("zoom",
+		func(node api.INode) []float64 {
+			return []float64{node.(*custom.ZoomNode).ZoomScale()}
+		},
+		func(node api.INode, values []float64) {
+			node.(*custom.ZoomNode).ScaleTo(values[0])
+		})
+
+	timelines, err := timeline.Load(world.WorkingPath() + zoneTimelines)
+	if err != nil {
+		log.Fatalf("ZoneManager: failed loading timelines: %s", err)
+	}
+
+	for _, t := range timelines {
+		t.Bind(z.zoom)
+	}
+	z.timelines = timelines
 }
 
 // GetZoom returns zoom INode
@@ -61,60 +84,49 @@ func (z *zoneManager) GetZoom() api.INode {
 	return z.zoom
 }
 
-// UpdateCheck updates zone tweens
+// UpdateCheck updates zones and the playing timeline
 func (z *zoneManager) UpdateCheck(point api.IPoint, msPerUpdate float64) {
-	isFinished := true
-
-	for _, zone := range z.zones {
-		grz := zone.(*ZoneCircleNode)
-		grz.UpdateCheck(point)
-
-		// Animate only the zone that was enter. The other zone's
-		// animation is frozen/stopped.
-		if z.enteredZoneID == zone.ID() {
-			z.zoomScale, isFinished = grz.TweenUpdate(msPerUpdate)
-			if !isFinished {
-				gz := z.zoom.(*custom.ZoomNode)
-				gz.ScaleTo(float64(z.zoomScale))
-			}
-		}
-	}
-}
+	z.zones.Check(objectStarShip, point)
 
-func (z *zoneManager) AnimationActive() bool {
-	return z.animationActive
+	if z.playing != nil {
+		z.playing.Update(msPerUpdate)
+	}
 }
 
-func (z *zoneManager) SetAnimationActive(active bool) {
-	z.animationActive = active
-}
+// play replaces the playing timeline. Its first keyframes take the
+// current values so an interrupted zoom continues smoothly.
+func (z *zoneManager) play(name string) {
+	t, found := z.timelines[name]
+	if !found {
+		return
+	}
 
-func (z *zoneManager) ZoomScale() float64 {
-	gz := z.zoom.(*custom.ZoomNode)
+	if z.playing != nil {
+		z.playing.Stop()
+	}
 
-	return gz.ZoomScale()
+	z.playing = t
+	t.Play()
 }
 
 // ----------------------------------------------------------
 // IZoneListener implementation
 // ----------------------------------------------------------
 
-// Notify receives messages from IZone objects
-func (z *zoneManager) Notify(state, id int) {
-	if state != api.CrossStateEntered {
+// Notify receives messages from the zones
+func (z *zoneManager) Notify(state, zoneID, objectID int) {
+	// Find zone that matches "zoneID"
+	zone := z.zoom.FindByID(zoneID)
+	if zone == nil {
 		return
 	}
 
-	z.enteredZoneID = id
-
-	// fmt.Println("ZM notified: ", z.enteredZoneID)
-
-	// Find zone that matches "id"
-	for _, zone := range z.zones {
-		if z.enteredZoneID == zone.ID() {
-			gz := z.zoom.(*custom.ZoomNode)
-			gz.SetFocalPoint(zone.Position().X(), zone.Position().Y())
-			break
-		}
+	switch state {
+	case api.CrossStateEntered:
+		gz := z.zoom.(*custom.ZoomNode)
+		gz.SetFocalPoint(zone.Position().X(), zone.Position().Y())
+		z.play(zone.Name() + ".enter")
+	case api.CrossStateExited:
+		z.play(zone.Name() + ".exit")
 	}
 }
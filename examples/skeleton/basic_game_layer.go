@@ -0,0 +1,153 @@
+package main
+
+import (
+	"math"
+
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine/animation/skeleton"
+	"github.com/wdevore/RangerGo/engine/geometry"
+	"github.com/wdevore/RangerGo/engine/maths"
+	"github.com/wdevore/RangerGo/engine/nodes"
+	"github.com/wdevore/RangerGo/engine/nodes/custom"
+	"github.com/wdevore/RangerGo/engine/rendering"
+)
+
+type gameLayer struct {
+	nodes.Node
+
+	crossNode      api.INode
+	cursorPosition api.IPoint
+
+	ship    *skeleton.Skeleton
+	arm     *skeleton.Skeleton
+	elapsed float64
+}
+
+func newBasicGameLayer(name string, parent api.INode) api.INode {
+	o := new(gameLayer)
+	o.Initialize(name)
+	o.SetParent(parent)
+	parent.AddChild(o)
+	return o
+}
+
+func (g *gameLayer) Build(world api.IWorld) {
+	g.Node.Build(world)
+
+	g.cursorPosition = geometry.NewPoint()
+
+	g.crossNode = custom.NewCrossNode("Cross", world, g)
+	g.crossNode.SetScale(30.0)
+	g.crossNode.SetPosition(300.0, -100.0)
+
+	g.buildShip(world)
+	g.buildArm(world)
+}
+
+// buildShip makes a hull with two nacelles on pylons. The nacelles
+// sway while cruising and flare outward, blended by a weight.
+func (g *gameLayer) buildShip(world api.IWorld) {
+	hull := skeleton.NewBone("Hull", world, g).(api.IBone)
+	hull.SetRest(-250.0, 0.0, -math.Pi/2.0)
+	mesh(world, hull, rendering.Orange, 0.0, -40.0, 120.0, 0.0, 0.0, 40.0)
+
+	cruise := skeleton.NewClip(2000.0)
+	flare := skeleton.NewClip(2000.0)
+
+	for _, side := range []struct {
+		name string
+		sign float64
+	}{{"Left", -1.0}, {"Right", 1.0}} {
+		pylon := skeleton.NewBone(side.name+"Pylon", world, hull).(api.IBone)
+		pylon.SetRest(30.0, side.sign*30.0, side.sign*math.Pi/2.0)
+		pylon.SetLength(40.0)
+		mesh(world, pylon, rendering.LightGray, 0.0, -3.0, 40.0, -3.0, 40.0, 3.0, 0.0, 3.0)
+
+		nacelle := skeleton.NewBone(side.name+"Nacelle", world, pylon).(api.IBone)
+		nacelle.SetRest(40.0, 0.0, -side.sign*math.Pi/2.0)
+		nacelle.SetLength(80.0)
+		mesh(world, nacelle, rendering.SoftBlue, -20.0, -8.0, 60.0, -8.0, 60.0, 8.0, -20.0, 8.0)
+
+		cruise.AddKey(nacelle.Name(), 0.0, 0.0)
+		cruise.AddKey(nacelle.Name(), 1000.0, side.sign*maths.DegreeToRadians*10.0)
+		cruise.AddKey(nacelle.Name(), 2000.0, 0.0)
+
+		flare.AddKey(pylon.Name(), 0.0, -side.sign*maths.DegreeToRadians*35.0)
+	}
+
+	g.ship = skeleton.NewSkeleton(hull)
+	g.ship.Play(cruise)
+	g.ship.Blend(flare, 0.0)
+}
+
+// buildArm makes a two bone arm that reaches for the cross.
+func (g *gameLayer) buildArm(world api.IWorld) {
+	upper := skeleton.NewBone("UpperArm", world, g).(api.IBone)
+	upper.SetRest(150.0, 150.0, -math.Pi/2.0)
+	upper.SetLength(150.0)
+	mesh(world, upper, rendering.SoftGreen, 0.0, -10.0, 150.0, -5.0, 150.0, 5.0, 0.0, 10.0)
+
+	lower := skeleton.NewBone("LowerArm", world, upper).(api.IBone)
+	lower.SetRest(150.0, 0.0, 0.0)
+	lower.SetLength(120.0)
+	mesh(world, lower, rendering.Yellow, 0.0, -5.0, 120.0, 0.0, 0.0, 5.0)
+
+	ik := skeleton.NewTwoBoneIK(upper, lower)
+	ik.SetTarget(g.crossNode)
+
+	g.arm = skeleton.NewSkeleton(upper)
+	g.arm.AddIK(ik)
+}
+
+// mesh attaches a polygon, in the bone's space, to a bone.
+func mesh(world api.IWorld, bone api.INode, color uint64, vertices ...float64) {
+	n := custom.NewPolygonNode(bone.Name()+" Mesh", world, bone)
+	poly := n.(*custom.PolygonNode)
+	poly.SetColor(rendering.NewPaletteInt64(color))
+	poly.EnableHitDetection(false)
+
+	for i := 0; i < len(vertices); i += 2 {
+		poly.AddVertex(vertices[i], vertices[i+1], i+2 == len(vertices))
+	}
+}
+
+// Update poses the skeletons. The flare weight swells and fades.
+func (g *gameLayer) Update(msPerUpdate, secPerUpdate float64) {
+	g.elapsed += secPerUpdate
+	g.ship.SetWeight((1.0 - math.Cos(g.elapsed)) / 2.0)
+
+	g.ship.Update(msPerUpdate)
+	g.arm.Update(msPerUpdate)
+}
+
+// -----------------------------------------------------
+// Node lifecycles
+// -----------------------------------------------------
+
+// EnterNode called when a node is entering the stage
+func (g *gameLayer) EnterNode(man api.INodeManager) {
+	man.RegisterTarget(g)
+	// The cross follows the mouse and the arm follows the cross.
+	man.RegisterEventTarget(g)
+}
+
+// ExitNode called when a node is exiting stage
+func (g *gameLayer) ExitNode(man api.INodeManager) {
+	man.UnRegisterTarget(g)
+	man.UnRegisterEventTarget(g)
+}
+
+// -----------------------------------------------------
+// IO events
+// -----------------------------------------------------
+
+func (g *gameLayer) Handle(event api.IEvent) bool {
+	if event.GetType() == api.IOTypeMouseMotion {
+		mx, my := event.GetMousePosition()
+		nodes.MapDeviceToView(g.World(), mx, my, g.cursorPosition)
+
+		g.crossNode.SetPosition(g.cursorPosition.X(), g.cursorPosition.Y())
+	}
+
+	return false
+}
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine/ai/steering"
+	"github.com/wdevore/RangerGo/engine/geometry"
+	"github.com/wdevore/RangerGo/engine/nodes"
+	"github.com/wdevore/RangerGo/engine/nodes/custom"
+	"github.com/wdevore/RangerGo/engine/rendering"
+)
+
+type boid struct {
+	node     api.INode
+	agent    *steering.NodeAgent
+	behavior api.ISteeringBehavior
+}
+
+type gameLayer struct {
+	nodes.Node
+
+	cursorPosition api.IPoint
+	crossNode      api.INode
+
+	boids []boid
+
+	// Half the view's size, for wrapping boids around the edges
+	halfW, halfH float64
+}
+
+func newBasicGameLayer(name string, parent api.INode) api.INode {
+	o := new(gameLayer)
+	o.Initialize(name)
+	o.SetParent(parent)
+	parent.AddChild(o)
+	return o
+}
+
+func (g *gameLayer) Build(world api.IWorld) {
+	g.Node.Build(world)
+
+	vw, vh := world.ViewSize().Components()
+	g.halfW = vw / 2.0
+	g.halfH = vh / 2.0
+
+	g.cursorPosition = geometry.NewPoint()
+	g.crossNode = custom.NewCrossNode("Cross", world, g)
+	g.crossNode.SetScale(30.0)
+
+	obstacles := []*geometry.Circle{}
+	for _, o := range [][3]float64{{-300.0, -100.0, 60.0}, {200.0, 150.0, 80.0}, {350.0, -200.0, 40.0}} {
+		c := geometry.NewCircle()
+		c.SetCenter(o[0], o[1])
+		c.SetRadius(o[2])
+		obstacles = append(obstacles, c)
+
+		n := custom.NewCircleNode("Obstacle", world, g)
+		n.(*custom.CircleNode).SetColor(rendering.NewPaletteInt64(rendering.LightGray))
+		n.SetPosition(o[0], o[1])
+		n.SetScale(o[2])
+	}
+
+	flock := steering.NewFlock()
+
+	// Boids avoid obstacles first. What force is left goes to keeping
+	// apart, flocking and drifting toward the cross.
+	for i := 0; i < 30; i++ {
+		n := custom.NewPolygonNode("Boid", world, g)
+		poly := n.(*custom.PolygonNode)
+		poly.SetColor(rendering.NewPaletteInt64(rendering.Orange))
+		poly.EnableHitDetection(false)
+		poly.AddVertex(10.0, 0.0, false)
+		poly.AddVertex(-6.0, 6.0, false)
+		poly.AddVertex(-6.0, -6.0, true)
+		n.SetPosition((rand.Float64()*2.0-1.0)*g.halfW, (rand.Float64()*2.0-1.0)*g.halfH)
+
+		agent := steering.NewNodeAgent(n, 150.0, 300.0)
+		agent.SetRadius(8.0)
+		agent.SetOrient(true)
+		agent.Velocity().SetDirectionByAngle(rand.Float64() * 2.0 * math.Pi)
+		flock.Add(agent)
+
+		flocking := steering.NewWeighted().
+			Add(steering.Separation(flock, 30.0), 2.0).
+			Add(steering.Alignment(flock, 60.0), 1.0).
+			Add(steering.Cohesion(flock, 60.0), 0.5).
+			Add(steering.Wander(20.0, 40.0, 0.3), 0.5).
+			Add(steering.Arrive(g.crossNode.Position(), 100.0), 0.2)
+
+		behavior := steering.NewPrioritized().
+			Add(steering.AvoidObstacles(obstacles, 0.5), 1.0).
+			Add(flocking, 1.0)
+
+		g.boids = append(g.boids, boid{node: n, agent: agent, behavior: behavior})
+	}
+}
+
+// Update steers the boids
+func (g *gameLayer) Update(msPerUpdate, secPerUpdate float64) {
+	for _, b := range g.boids {
+		steering.Update(b.agent, b.behavior, secPerUpdate)
+
+		// Wrap around the view's edges
+		p := b.agent.Position()
+		x := math.Mod(p.X()+g.halfW*3.0, g.halfW*2.0) - g.halfW
+		y := math.Mod(p.Y()+g.halfH*3.0, g.halfH*2.0) - g.halfH
+		if x != p.X() || y != p.Y() {
+			b.node.SetPosition(x, y)
+		}
+	}
+}
+
+// -----------------------------------------------------
+// Node lifecycles
+// -----------------------------------------------------
+
+// EnterNode called when a node is entering the stage
+func (g *gameLayer) EnterNode(man api.INodeManager) {
+	man.RegisterTarget(g)
+	man.RegisterEventTarget(g)
+}
+
+// ExitNode called when a node is exiting stage
+func (g *gameLayer) ExitNode(man api.INodeManager) {
+	man.UnRegisterTarget(g)
+	man.UnRegisterEventTarget(g)
+}
+
+// -----------------------------------------------------
+// IO events
+// -----------------------------------------------------
+
+func (g *gameLayer) Handle(event api.IEvent) bool {
+	if event.GetType() == api.IOTypeMouseMotion {
+		mx, my := event.GetMousePosition()
+		nodes.MapDeviceToView(g.World(), mx, my, g.cursorPosition)
+
+		g.crossNode.SetPosition(g.cursorPosition.X(), g.cursorPosition.Y())
+	}
+
+	return false
+}
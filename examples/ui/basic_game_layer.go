@@ -0,0 +1,133 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine/nodes"
+	"github.com/wdevore/RangerGo/engine/nodes/custom"
+	"github.com/wdevore/RangerGo/engine/nodes/ui"
+	"github.com/wdevore/RangerGo/engine/rendering"
+)
+
+type gameLayer struct {
+	nodes.Node
+
+	status *ui.Label
+	square api.INode
+}
+
+func newBasicGameLayer(name string, parent api.INode) api.INode {
+	o := new(gameLayer)
+	o.Initialize(name)
+	o.SetParent(parent)
+	parent.AddChild(o)
+	return o
+}
+
+func (g *gameLayer) Build(world api.IWorld) {
+	g.Node.Build(world)
+
+	g.square = custom.NewRectangleNode("Orange Rect", world, g)
+	g.square.(*custom.RectangleNode).SetColor(rendering.NewPaletteInt64(rendering.Orange))
+	g.square.SetScale(100.0)
+	g.square.SetPosition(300.0, 0.0)
+
+	vw, vh := world.ViewSize().Components()
+
+	// The root panel receives the IO events and manages focus.
+	root := ui.NewPanel("Controls", world, g)
+	root.SetPosition(-vw/2.0+20.0, -vh/2.0+20.0)
+	panel := root.(*ui.Panel)
+
+	title := ui.NewLabel("Title", world, panel).(*ui.Label)
+	title.SetText("SQUARE CONTROLS")
+
+	// A nested panel with a horizontal layout for the buttons.
+	buttons := ui.NewPanel("Buttons", world, panel).(*ui.Panel)
+	buttons.SetTransparent(true)
+	buttons.SetLayout(ui.NewHorizontalLayout(6.0, false))
+
+	left := ui.NewButton("Left", world, buttons).(*ui.Button)
+	left.SetText("LEFT")
+	left.SetAction(func(w api.IWidget) { g.moveSquare(-25.0) })
+
+	right := ui.NewButton("Right", world, buttons).(*ui.Button)
+	right.SetText("RIGHT")
+	right.SetAction(func(w api.IWidget) { g.moveSquare(25.0) })
+
+	visible := ui.NewCheckbox("Visible", world, panel).(*ui.Checkbox)
+	visible.SetText("VISIBLE")
+	visible.SetChecked(true)
+	visible.SetAction(func(w api.IWidget) {
+		g.square.SetVisible(w.(*ui.Checkbox).IsChecked())
+	})
+
+	scale := ui.NewSlider("Scale", world, panel).(*ui.Slider)
+	scale.SetRange(25.0, 200.0, 5.0)
+	scale.SetValue(100.0)
+	scale.SetAction(func(w api.IWidget) {
+		g.square.SetScale(w.(*ui.Slider).Value())
+		g.setStatus(fmt.Sprintf("SCALE %0.0f", w.(*ui.Slider).Value()))
+	})
+
+	name := ui.NewTextInput("Name", world, panel).(*ui.TextInput)
+	name.SetMaxLength(20)
+	name.SetAction(func(w api.IWidget) {
+		g.setStatus("HELLO " + w.(*ui.TextInput).Text())
+	})
+
+	colors := ui.NewList("Colors", world, panel).(*ui.List)
+	colors.SetRows(4)
+	colors.AddItem("ORANGE")
+	colors.AddItem("SOFT GREEN")
+	colors.AddItem("SOFT BLUE")
+	colors.AddItem("YELLOW")
+	colors.AddItem("WHITE")
+	palette := []uint64{rendering.Orange, rendering.SoftGreen, rendering.SoftBlue, rendering.Yellow, rendering.White}
+	colors.SetAction(func(w api.IWidget) {
+		selected := w.(*ui.List).Selected()
+		g.square.(*custom.RectangleNode).SetColor(rendering.NewPaletteInt64(palette[selected]))
+		g.setStatus(w.(*ui.List).SelectedItem())
+	})
+
+	g.status = ui.NewLabel("Status", world, panel).(*ui.Label)
+	g.status.SetText("TAB CYCLES FOCUS")
+
+	// A second root panel anchored in the lower right using a
+	// custom theme.
+	theme := ui.NewTheme()
+	theme.Background = rendering.NewPaletteInt64(rendering.Navy)
+	theme.Accent = rendering.NewPaletteInt64(rendering.Orange)
+
+	help := ui.NewPanel("Help", world, g).(*ui.Panel)
+	help.SetTheme(theme)
+	help.SetFixedSize(300.0, 100.0)
+	help.SetPosition(vw/2.0-320.0, vh/2.0-120.0)
+	anchors := ui.NewAnchorLayout()
+	help.SetLayout(anchors)
+
+	hint := ui.NewLabel("Hint", world, help).(*ui.Label)
+	hint.SetText("ESC TO QUIT")
+	anchors.SetAnchor(hint, api.AnchorTopLeft, 0.0, 0.0)
+
+	reset := ui.NewButton("Reset", world, help).(*ui.Button)
+	reset.SetText("RESET")
+	reset.SetAction(func(w api.IWidget) {
+		g.square.SetPosition(300.0, 0.0)
+		scale.SetValue(100.0)
+		g.square.SetScale(100.0)
+		g.setStatus("RESET")
+	})
+	anchors.SetAnchor(reset, api.AnchorBottomRight, 0.0, 0.0)
+}
+
+func (g *gameLayer) moveSquare(dx float64) {
+	pos := g.square.Position()
+	g.square.SetPosition(pos.X()+dx, pos.Y())
+	g.setStatus(fmt.Sprintf("X %0.0f", pos.X()))
+}
+
+func (g *gameLayer) setStatus(text string) {
+	g.status.SetText(text)
+}
@@ -20,8 +20,9 @@ type sceneSplash struct {
 	backgroundMin   api.IPoint
 	backgroundMax   api.IPoint
 
-	text     api.INode
-	alphabet api.INode
+	text      api.INode
+	alphabet  api.INode
+	paragraph api.INode
 }
 
 func newBasicSplashScene(name string, replacement api.INode) api.INode {
@@ -57,6 +58,16 @@ func (s *sceneSplash) Build(world api.IWorld) {
 	s.alphabet.SetScale(25.0)
 	s.alphabet.SetPosition(-800.0, -100.0)
 
+	s.paragraph = custom.NewVectorTextNode(world, s)
+	s.paragraph.Initialize("Paragraph")
+	t = s.paragraph.(*custom.VectorTextNode)
+	t.SetText("PROPORTIONAL TEXT CENTERED AND WRAPPED\nACROSS SEVERAL LINES")
+	t.SetAlignment(api.TextAlignCenter)
+	t.SetProportional(true)
+	t.SetMaxWidth(20.0)
+	s.paragraph.SetScale(20.0)
+	s.paragraph.SetPosition(0.0, 150.0)
+
 }
 
 // --------------------------------------------------------
@@ -5,6 +5,5 @@ go 1.13
 require (
 	github.com/ByteArena/box2d v1.0.2
 	github.com/stretchr/testify v1.5.1 // indirect
-	github.com/tanema/gween v0.0.0-20190613150852-fbc00f26ef8f
 	github.com/veandco/go-sdl2 v0.4.0
 )
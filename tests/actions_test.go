@@ -0,0 +1,184 @@
+package actions
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine"
+	"github.com/wdevore/RangerGo/engine/animation/actions"
+	"github.com/wdevore/RangerGo/engine/nodes"
+	"github.com/wdevore/RangerGo/engine/nodes/custom"
+	"github.com/wdevore/RangerGo/engine/rendering"
+)
+
+type scene struct {
+	nodes.Node
+	nodes.Scene
+}
+
+func (s *scene) TransitionAction() int {
+	return api.SceneNoAction
+}
+
+func TestRunner(t *testing.T) {
+	world := engine.NewWorld("Actions", 1.0, "../examples")
+
+	runSequence(t, world)
+	runParallelAndRepeat(t, world)
+	runTint(t, world)
+	runStop(t, world)
+}
+
+func newNode(world api.IWorld) api.INode {
+	n := nodes.NewNode()
+	n.Initialize("Node")
+	n.Build(world)
+	return n
+}
+
+func expect(t *testing.T, what string, got, want float64) {
+	if math.Abs(got-want) > 1e-9 {
+		t.Fatalf("Expected %s %0.3f, got %0.3f", what, want, got)
+	}
+}
+
+func runSequence(t *testing.T, world api.IWorld) {
+	node := newNode(world)
+	runner := actions.NewRunner()
+
+	called := 0
+	runner.Run(node, actions.Sequence(
+		actions.MoveTo(100.0, 100.0, 0.0),
+		actions.Delay(50.0),
+		actions.MoveBy(100.0, 0.0, 50.0),
+		actions.Call(func(target api.INode) { called++ }),
+	))
+
+	runner.Update(50.0)
+	expect(t, "x", node.Position().X(), 50.0)
+
+	// Time left over by the move is passed to the delay.
+	runner.Update(75.0)
+	expect(t, "x", node.Position().X(), 100.0)
+	expect(t, "y", node.Position().Y(), 0.0)
+
+	runner.Update(50.0)
+	expect(t, "y", node.Position().Y(), 12.5)
+
+	runner.Update(75.0)
+	expect(t, "y", node.Position().Y(), 50.0)
+
+	if called != 1 {
+		t.Fatalf("Expected the callback once, got %d", called)
+	}
+	if runner.IsRunning(node) {
+		t.Fatal("Expected the sequence to finish")
+	}
+}
+
+func runParallelAndRepeat(t *testing.T, world api.IWorld) {
+	node := newNode(world)
+	runner := actions.NewRunner()
+
+	runner.Run(node, actions.Repeat(2, actions.Parallel(
+		actions.RotateBy(100.0, 1.0),
+		actions.FadeTo(50.0, 0.0),
+		actions.ScaleTo(100.0, 3.0),
+	)))
+
+	runner.Update(50.0)
+	expect(t, "rotation", node.Rotation(), 0.5)
+	expect(t, "opacity", node.Opacity(), 0.0)
+	expect(t, "scale", node.Scale(), 2.0)
+
+	// The second repeat starts from where the first finished.
+	runner.Update(100.0)
+	expect(t, "rotation", node.Rotation(), 1.5)
+
+	runner.Update(50.0)
+	expect(t, "rotation", node.Rotation(), 2.0)
+	expect(t, "scale", node.Scale(), 3.0)
+
+	if runner.IsRunning(node) {
+		t.Fatal("Expected the repeat to finish")
+	}
+
+	// An action that takes no time doesn't hang a forever repeat.
+	count := 0
+	runner.Run(node, actions.RepeatForever(actions.Call(func(target api.INode) { count++ })))
+	runner.Update(10.0)
+	if count != 1 {
+		t.Fatalf("Expected one call per update, got %d", count)
+	}
+}
+
+func runTint(t *testing.T, world api.IWorld) {
+	root := newNode(world)
+	shared := rendering.NewPaletteInt64(0x000000ff)
+
+	rect := custom.NewRectangleNode("Rect", world, root)
+	rect.(*custom.RectangleNode).SetColor(shared)
+
+	runner := actions.NewRunner()
+	runner.Run(rect, actions.TintTo(100.0, rendering.NewPaletteInt64(0xc864ffff)))
+	runner.Update(50.0)
+
+	color := rect.(api.IColorable).Color()
+	if color.R() != 100 || color.G() != 50 || color.B() != 128 {
+		t.Fatalf("Expected half way tint, got %v", color.Color())
+	}
+	if shared.AsUInt64() != 0x000000ff {
+		t.Fatal("Expected the shared palette to be unchanged")
+	}
+
+	// Non colorable nodes are ignored.
+	runner.Run(newNode(world), actions.TintTo(100.0, shared))
+	runner.Update(100.0)
+}
+
+func runStop(t *testing.T, world api.IWorld) {
+	node := newNode(world)
+	other := newNode(world)
+	runner := actions.NewRunner()
+
+	move := actions.MoveBy(100.0, 100.0, 0.0)
+	runner.Run(node, move)
+	runner.Run(node, actions.RotateBy(100.0, 1.0))
+	runner.Run(other, actions.MoveBy(100.0, 100.0, 0.0))
+
+	runner.StopAction(move)
+	runner.Update(50.0)
+	expect(t, "x", node.Position().X(), 0.0)
+	expect(t, "rotation", node.Rotation(), 0.5)
+
+	runner.Stop(node)
+	runner.Update(50.0)
+	expect(t, "rotation", node.Rotation(), 0.5)
+	expect(t, "other x", other.Position().X(), 100.0)
+
+	// Actions can be run from a callback while updating.
+	runner.Run(node, actions.Call(func(target api.INode) {
+		runner.Run(target, actions.MoveBy(10.0, 10.0, 0.0))
+	}))
+	runner.Update(10.0)
+	runner.Update(10.0)
+	expect(t, "x", node.Position().X(), 10.0)
+
+	// Actions stop when their node exits the stage.
+	manager := nodes.NewNodeManager(world)
+	s := new(scene)
+	s.Initialize("Scene")
+	s.Build(world)
+	child := newNode(world)
+	nodes.Attach(s, child)
+
+	manager.PushNode(s)
+	manager.Visit(0.0)
+	manager.Actions().Run(child, actions.Delay(100.0))
+	manager.End()
+
+	if manager.Actions().IsRunning(child) {
+		t.Fatal("Expected actions to stop when exiting")
+	}
+}
@@ -0,0 +1,234 @@
+package ai
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine"
+	"github.com/wdevore/RangerGo/engine/ai/behavior"
+	"github.com/wdevore/RangerGo/engine/ai/fsm"
+	"github.com/wdevore/RangerGo/engine/nodes"
+)
+
+func TestRunner(t *testing.T) {
+	world := engine.NewWorld("AI", 1.0, "../examples")
+
+	runMachine(t, world)
+	runSequenceAndSelector(t)
+	runDecorators(t)
+	runDescribe(t)
+}
+
+func runMachine(t *testing.T, world api.IWorld) {
+	owner := nodes.NewNode()
+	owner.Initialize("Guard")
+	owner.Build(world)
+
+	trace := []string{}
+	alert := false
+
+	m := fsm.NewMachine(owner)
+	m.AddState(fsm.NewState("idle").
+		OnEnter(func(o api.INode) { trace = append(trace, "enter idle") }).
+		OnExit(func(o api.INode) { trace = append(trace, "exit idle") }))
+	m.AddState(fsm.NewState("chase").
+		OnEnter(func(o api.INode) { trace = append(trace, "enter chase") }).
+		OnUpdate(func(o api.INode, dt float64) { o.SetPosition(o.Position().X()+dt, 0.0) }))
+	m.AddState(fsm.NewState("rest"))
+
+	m.AddTransition("idle", "chase", func() bool { return alert })
+	m.AddTransition("chase", "idle", func() bool { return !alert })
+	// Any state rests after a second
+	m.AddTransition("", "rest", func() bool { return m.Elapsed() >= 1000.0 })
+
+	m.Start("idle")
+	m.Update(100.0)
+	if !m.Is("idle") || m.Elapsed() != 100.0 {
+		t.Fatalf("Expected to stay idle, got %s", m.Describe())
+	}
+
+	alert = true
+	m.Update(100.0)
+	if !m.Is("chase") || m.Previous().Name() != "idle" {
+		t.Fatalf("Expected to chase, got %s", m.Describe())
+	}
+	if owner.Position().X() != 100.0 {
+		t.Fatalf("Expected the chase state to update, at %v", owner.Position())
+	}
+
+	if strings.Join(trace, ",") != "enter idle,exit idle,enter chase" {
+		t.Fatalf("Unexpected hooks: %v", trace)
+	}
+
+	for i := 0; i < 11; i++ {
+		m.Update(100.0)
+	}
+	if !m.Is("rest") {
+		t.Fatalf("Expected the any state transition, got %s", m.Describe())
+	}
+
+	// The any state transition doesn't re-enter rest.
+	elapsed := m.Elapsed()
+	m.Update(100.0)
+	if m.Elapsed() != elapsed+100.0 {
+		t.Fatalf("Expected to stay in rest, got %s", m.Describe())
+	}
+
+	if m.Change("missing") {
+		t.Fatal("Expected changing to an unknown state to fail")
+	}
+
+	// A nil guard always passes, and a transition to an unknown state
+	// leaves the current state.
+	m.AddTransition("rest", "missing", nil)
+	m.AddTransition("rest", "idle", nil)
+	m.Update(100.0)
+	if !m.Is("rest") {
+		t.Fatalf("Expected to stay in rest, got %s", m.Describe())
+	}
+
+	m = fsm.NewMachine(owner)
+	m.AddState(fsm.NewState("idle"))
+	m.AddState(fsm.NewState("chase"))
+	m.AddTransition("idle", "chase", nil)
+	m.Start("idle")
+	m.Update(100.0)
+	if !m.Is("chase") {
+		t.Fatalf("Expected the unguarded transition, got %s", m.Describe())
+	}
+}
+
+func counter(name string, status int, count *int) api.IBehavior {
+	return behavior.Action(name, func(board api.IBlackboard, dt float64) int {
+		*count++
+		return status
+	})
+}
+
+func runSequenceAndSelector(t *testing.T) {
+	a, b, c := 0, 0, 0
+
+	// The sequence stops at the first failure.
+	seq := behavior.Sequence("Seq",
+		counter("A", api.BehaviorSuccess, &a),
+		counter("B", api.BehaviorFailure, &b),
+		counter("C", api.BehaviorSuccess, &c))
+
+	board := behavior.NewBlackboard()
+	if seq.Tick(board, 16.0) != api.BehaviorFailure || a != 1 || b != 1 || c != 0 {
+		t.Fatalf("Expected the sequence to fail at B, got %d %d %d", a, b, c)
+	}
+
+	// The selector stops at the first success.
+	a, b = 0, 0
+	sel := behavior.Selector("Sel",
+		counter("B", api.BehaviorFailure, &b),
+		counter("A", api.BehaviorSuccess, &a),
+		counter("C", api.BehaviorSuccess, &c))
+	if sel.Tick(board, 16.0) != api.BehaviorSuccess || a != 1 || b != 1 || c != 0 {
+		t.Fatalf("Expected the selector to succeed at A, got %d %d %d", a, b, c)
+	}
+
+	// A running child is resumed rather than restarting the sequence.
+	a = 0
+	seq = behavior.Sequence("Seq",
+		counter("A", api.BehaviorSuccess, &a),
+		behavior.Wait(100.0))
+	tree := behavior.NewTree(seq)
+	tree.Update(40.0)
+	tree.Update(40.0)
+	if tree.Status() != api.BehaviorRunning {
+		t.Fatal("Expected the wait to be running")
+	}
+	if tree.Update(40.0) != api.BehaviorSuccess || a != 1 {
+		t.Fatalf("Expected one run of A and success, got %d %d", a, tree.Status())
+	}
+
+	// Blackboard
+	tree.Blackboard().Set("target", 3)
+	if !tree.Blackboard().Has("target") || tree.Blackboard().Get("target").(int) != 3 {
+		t.Fatal("Expected the blackboard value")
+	}
+	tree.Blackboard().Delete("target")
+	if tree.Blackboard().Get("target") != nil {
+		t.Fatal("Expected the value to be deleted")
+	}
+}
+
+func runDecorators(t *testing.T) {
+	board := behavior.NewBlackboard()
+	n := 0
+
+	if behavior.Invert(counter("F", api.BehaviorFailure, &n)).Tick(board, 0.0) != api.BehaviorSuccess {
+		t.Fatal("Expected invert to succeed")
+	}
+	if behavior.Succeed(counter("F", api.BehaviorFailure, &n)).Tick(board, 0.0) != api.BehaviorSuccess {
+		t.Fatal("Expected succeed to succeed")
+	}
+
+	// Repeat finishes its child once per tick.
+	n = 0
+	repeat := behavior.Repeat(3, counter("S", api.BehaviorSuccess, &n))
+	status := []int{repeat.Tick(board, 0.0), repeat.Tick(board, 0.0), repeat.Tick(board, 0.0)}
+	if status[0] != api.BehaviorRunning || status[1] != api.BehaviorRunning || status[2] != api.BehaviorSuccess || n != 3 {
+		t.Fatalf("Expected three runs, got %v and %d", status, n)
+	}
+
+	// Reset mid-repeat starts the count over.
+	n = 0
+	repeat.Tick(board, 0.0)
+	repeat.Tick(board, 0.0)
+	repeat.Reset()
+	status = []int{repeat.Tick(board, 0.0), repeat.Tick(board, 0.0), repeat.Tick(board, 0.0)}
+	if status[0] != api.BehaviorRunning || status[1] != api.BehaviorRunning || status[2] != api.BehaviorSuccess || n != 5 {
+		t.Fatalf("Expected three runs after reset, got %v and %d", status, n)
+	}
+
+	// Until fail counts down the blackboard
+	board.Set("ammo", 2)
+	fire := behavior.UntilFail(behavior.Condition("Fire", func(board api.IBlackboard) bool {
+		ammo := board.Get("ammo").(int)
+		board.Set("ammo", ammo-1)
+		return ammo > 0
+	}))
+	ticks := 1
+	for fire.Tick(board, 0.0) == api.BehaviorRunning {
+		ticks++
+	}
+	if ticks != 3 || board.Get("ammo").(int) != -1 {
+		t.Fatalf("Expected three ticks, got %d", ticks)
+	}
+}
+
+func runDescribe(t *testing.T) {
+	patrol := behavior.Sequence("Patrol",
+		behavior.Action("Walk", func(board api.IBlackboard, dt float64) int { return api.BehaviorSuccess }),
+		behavior.Wait(1000.0))
+	tree := behavior.NewTree(behavior.Selector("Root",
+		behavior.Condition("Enemy?", func(board api.IBlackboard) bool { return false }),
+		patrol))
+
+	tree.Update(16.0)
+
+	expected := "Root running\n  Patrol running\n    Wait running"
+	if tree.Describe() != expected {
+		t.Fatalf("Expected:\n%s\ngot:\n%s", expected, tree.Describe())
+	}
+
+	// Reset abandons the wait
+	tree.Reset()
+	for i := 0; i < 62; i++ {
+		tree.Update(16.0)
+	}
+	if tree.Status() != api.BehaviorRunning {
+		t.Fatal("Expected the wait to restart after a reset")
+	}
+
+	// Unknown statuses are described by value
+	tree = behavior.NewTree(behavior.Action("Odd", func(board api.IBlackboard, dt float64) int { return 7 }))
+	tree.Update(16.0)
+	if tree.Describe() != "Odd 7" {
+		t.Fatalf("Expected the raw status, got %s", tree.Describe())
+	}
+}
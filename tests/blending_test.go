@@ -0,0 +1,75 @@
+package blending
+
+import (
+	"testing"
+
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine"
+	"github.com/wdevore/RangerGo/engine/nodes"
+	"github.com/wdevore/RangerGo/engine/rendering"
+)
+
+// probe records the context's state when drawn
+type probe struct {
+	nodes.Node
+
+	opacity float64
+	blend   int
+}
+
+func newProbe(name string, world api.IWorld, parent api.INode) *probe {
+	o := new(probe)
+	o.Initialize(name)
+	o.SetParent(parent)
+	parent.AddChild(o)
+	o.Build(world)
+	return o
+}
+
+func (p *probe) Draw(context api.IRenderContext) {
+	p.opacity = context.Opacity()
+	p.blend = context.BlendMode()
+}
+
+func TestRunner(t *testing.T) {
+	world := engine.NewWorld("Blending", 1.0, "../examples")
+
+	context := rendering.NewRenderContext(world)
+	context.Initialize()
+
+	runCascade(t, world, context)
+}
+
+func runCascade(t *testing.T, world api.IWorld, context api.IRenderContext) {
+	root := nodes.NewNode()
+	root.Initialize("Root")
+	root.Build(world)
+
+	parent := newProbe("Parent", world, root)
+	parent.SetOpacity(0.5)
+	parent.SetBlendMode(api.BlendAdditive)
+
+	child := newProbe("Child", world, parent)
+	child.SetOpacity(0.5)
+
+	sibling := newProbe("Sibling", world, root)
+
+	nodes.Visit(root, context, 1.0)
+
+	if parent.opacity != 0.5 || child.opacity != 0.25 {
+		t.Fatalf("Expected opacities 0.5 and 0.25, got %f and %f", parent.opacity, child.opacity)
+	}
+
+	if child.blend != api.BlendAdditive {
+		t.Fatalf("Expected child to inherit additive blending, got %d", child.blend)
+	}
+
+	// The parent's state is restored before its sibling is visited.
+	if sibling.opacity != 1.0 || sibling.blend != api.BlendAlpha {
+		t.Fatalf("Expected sibling opacity 1 and alpha blending, got %f and %d", sibling.opacity, sibling.blend)
+	}
+
+	if context.Opacity() != 1.0 || context.BlendMode() != api.BlendAlpha {
+		t.Fatal("Expected context state to be restored")
+	}
+}
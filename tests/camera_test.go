@@ -0,0 +1,162 @@
+package camera
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine"
+	"github.com/wdevore/RangerGo/engine/geometry"
+	"github.com/wdevore/RangerGo/engine/misc"
+	"github.com/wdevore/RangerGo/engine/nodes"
+	"github.com/wdevore/RangerGo/engine/nodes/custom"
+	"github.com/wdevore/RangerGo/engine/rendering"
+)
+
+// rebuilder counts how often it rebuilds while dirty
+type rebuilder struct {
+	nodes.Node
+
+	rebuilds int
+}
+
+func (r *rebuilder) Draw(context api.IRenderContext) {
+	if r.IsDirty() {
+		r.rebuilds++
+		r.SetDirty(false)
+	}
+}
+
+func TestRunner(t *testing.T) {
+	runFollow(t)
+	runBounds(t)
+	runShake(t)
+
+	world := engine.NewWorld("Camera", 1.0, "../examples")
+	root := nodes.NewNode()
+	root.Initialize("Root")
+	root.Build(world)
+
+	runMappings(t, world, root)
+	runRipple(t, world, root)
+}
+
+func runFollow(t *testing.T) {
+	camera := misc.NewCamera()
+	camera.SetDeadZone(20.0, 20.0)
+	camera.SetSmoothing(0.5)
+
+	// Inside the dead-zone nothing moves.
+	camera.Track(5.0, -5.0)
+	camera.Update(16.0)
+	if camera.Position().X() != 0.0 || camera.Position().Y() != 0.0 {
+		t.Fatalf("Expected camera to stay put, got %v", camera.Position())
+	}
+
+	// Outside it closes half the distance to the dead-zone's edge.
+	camera.Track(50.0, 0.0)
+	camera.Update(16.0)
+	if camera.Position().X() != 20.0 {
+		t.Fatalf("Expected x = 20, got %f", camera.Position().X())
+	}
+}
+
+func runBounds(t *testing.T) {
+	camera := misc.NewCamera()
+	camera.SetViewSize(100.0, 50.0)
+	camera.SetBounds(-200.0, -100.0, 200.0, 100.0)
+
+	camera.SetPosition(500.0, -500.0)
+	if camera.Position().X() != 150.0 || camera.Position().Y() != -75.0 {
+		t.Fatalf("Expected clamped position (150,-75), got %v", camera.Position())
+	}
+
+	// Zooming out shows more, so the camera must stay further in.
+	camera.SetZoom(0.5)
+	if camera.Position().X() != 100.0 {
+		t.Fatalf("Expected clamped x = 100, got %f", camera.Position().X())
+	}
+}
+
+func runShake(t *testing.T) {
+	camera := misc.NewCamera()
+	camera.Shake(10.0, 100.0)
+
+	camera.Update(50.0)
+	camera.Interpolate(1.0)
+	x, y := camera.Transform().TransformToComps(geometry.NewPoint())
+	if math.Abs(x) > 5.0 || math.Abs(y) > 5.0 {
+		t.Fatalf("Expected shake within half magnitude, got (%f,%f)", x, y)
+	}
+
+	camera.Update(60.0)
+	camera.Interpolate(1.0)
+	x, y = camera.Transform().TransformToComps(geometry.NewPoint())
+	if x != 0.0 || y != 0.0 {
+		t.Fatalf("Expected shake to end, got (%f,%f)", x, y)
+	}
+}
+
+func runMappings(t *testing.T, world api.IWorld, root api.INode) {
+	node := custom.NewCameraNode("Camera", world, root)
+	cam := node.(*custom.CameraNode)
+	cam.Camera(0).SetPosition(100.0, 50.0)
+	cam.Camera(0).Interpolate(1.0)
+
+	child := custom.NewCrossNode("Child", world, cam)
+	child.SetPosition(100.0, 50.0)
+
+	// The child is where the camera looks so it maps to the window center.
+	device := geometry.NewPoint()
+	nodes.MapNodeToDevice(world, child, device)
+
+	cx, cy := world.WindowSize().X()/2.0, world.WindowSize().Y()/2.0
+	if math.Abs(device.X()-cx) > 0.001 || math.Abs(device.Y()-cy) > 0.001 {
+		t.Fatalf("Expected window center, got %v", device)
+	}
+
+	// A minimap viewport maps its own center to the camera's position.
+	mini := cam.AddViewport(10.0, 20.0, 200.0, 100.0)
+	cam.Camera(mini).SetPosition(100.0, 50.0)
+	cam.Camera(mini).Interpolate(1.0)
+	cam.SetActiveViewport(cam.ViewportAt(110, 70))
+
+	local := geometry.NewPoint()
+	nodes.MapDeviceToNode(110, 70, child, local)
+	if math.Abs(local.X()) > 0.001 || math.Abs(local.Y()) > 0.001 {
+		t.Fatalf("Expected child's origin, got %v", local)
+	}
+}
+
+func runRipple(t *testing.T, world api.IWorld, root api.INode) {
+	context := rendering.NewRenderContext(world)
+	context.Initialize()
+
+	cam := custom.NewCameraNode("Camera", world, root).(*custom.CameraNode)
+	child := new(rebuilder)
+	child.Initialize("Child")
+	child.SetParent(cam)
+	cam.AddChild(child)
+	child.Build(world)
+
+	// A still camera doesn't dirty its children.
+	cam.Visit(context, 1.0)
+	cam.Visit(context, 1.0)
+	if child.rebuilds != 1 {
+		t.Fatalf("Expected one rebuild, got %d", child.rebuilds)
+	}
+
+	cam.Camera(0).SetPosition(10.0, 0.0)
+	cam.Visit(context, 1.0)
+	cam.Visit(context, 1.0)
+	if child.rebuilds != 2 {
+		t.Fatalf("Expected a rebuild after moving, got %d", child.rebuilds)
+	}
+
+	// Each viewport transforms the children differently.
+	cam.AddViewport(10.0, 20.0, 200.0, 100.0)
+	cam.Visit(context, 1.0)
+	if child.rebuilds != 4 {
+		t.Fatalf("Expected a rebuild per viewport, got %d", child.rebuilds)
+	}
+}
@@ -0,0 +1,68 @@
+package clip
+
+import (
+	"testing"
+
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine"
+	"github.com/wdevore/RangerGo/engine/geometry"
+	"github.com/wdevore/RangerGo/engine/maths"
+	"github.com/wdevore/RangerGo/engine/rendering"
+)
+
+func TestRunner(t *testing.T) {
+	world := engine.NewWorld("Clip", 1.0, "../examples")
+
+	context := rendering.NewRenderContext(world)
+	context.Initialize()
+
+	runClipStack(t, world, context)
+}
+
+func runClipStack(t *testing.T, world api.IWorld, context api.IRenderContext) {
+	if context.ClipRect() != nil {
+		t.Fatal("Expected no clip initially")
+	}
+
+	// View-space is centered on the window.
+	cx, cy := world.WindowSize().X()/2.0, world.WindowSize().Y()/2.0
+
+	context.PushClip(geometry.NewRectangleUsing(-10.0, -10.0, 10.0, 10.0))
+	expectClip(t, context, cx-10.0, cy-10.0, cx+10.0, cy+10.0)
+
+	// Nested clips intersect with the parent's.
+	context.Save()
+	shift := maths.NewTransform()
+	shift.MakeTranslate(5.0, 0.0)
+	context.Apply(shift)
+
+	context.PushClip(geometry.NewRectangleUsing(0.0, -20.0, 30.0, 20.0))
+	expectClip(t, context, cx+5.0, cy-10.0, cx+10.0, cy+10.0)
+
+	// Disjoint clips draw nothing.
+	context.PushClip(geometry.NewRectangleUsing(100.0, 100.0, 110.0, 110.0))
+	min, max := context.ClipRect().Min(), context.ClipRect().Max()
+	if min.X() != max.X() || min.Y() != max.Y() {
+		t.Fatalf("Expected an empty clip, got %v", context.ClipRect())
+	}
+
+	// Restore removes clips pushed since Save.
+	context.Restore()
+	expectClip(t, context, cx-10.0, cy-10.0, cx+10.0, cy+10.0)
+
+	context.PopClip()
+	if context.ClipRect() != nil {
+		t.Fatal("Expected no clip after pop")
+	}
+}
+
+func expectClip(t *testing.T, context api.IRenderContext, minX, minY, maxX, maxY float64) {
+	clip := context.ClipRect()
+	if clip == nil {
+		t.Fatal("Expected a clip")
+	}
+
+	if clip.Min().X() != minX || clip.Min().Y() != minY || clip.Max().X() != maxX || clip.Max().Y() != maxY {
+		t.Fatalf("Expected clip (%0.1f,%0.1f)-(%0.1f,%0.1f), got %v", minX, minY, maxX, maxY, clip)
+	}
+}
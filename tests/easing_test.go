@@ -0,0 +1,111 @@
+package easing
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine/animation/tweening"
+)
+
+const epsilon = 1e-6
+
+func TestRunner(t *testing.T) {
+	runEquations(t)
+	runBezier(t)
+	runTween(t)
+}
+
+func runEquations(t *testing.T) {
+	for equation := api.EquationLinear; equation <= api.EquationBounce; equation++ {
+		for _, style := range []int{api.EaseIn, api.EaseOut, api.EaseInOut} {
+			e := tweening.NewEquation(equation, style)
+
+			if v := e.Compute(0.0); math.Abs(v) > epsilon {
+				t.Fatalf("Equation %d/%d: expected 0.0 at start, got %f", equation, style, v)
+			}
+			if v := e.Compute(1.0); math.Abs(v-1.0) > epsilon {
+				t.Fatalf("Equation %d/%d: expected 1.0 at end, got %f", equation, style, v)
+			}
+			if style == api.EaseInOut {
+				if v := e.Compute(0.5); math.Abs(v-0.5) > epsilon {
+					t.Fatalf("Equation %d: expected 0.5 half way, got %f", equation, v)
+				}
+			}
+		}
+	}
+
+	// Back overshoots rather than being clamped.
+	back := tweening.NewBackEquation(api.EaseIn)
+	if back.Compute(0.2) >= 0.0 {
+		t.Fatal("Expected Back to undershoot")
+	}
+
+	// Easing in mirrors easing out.
+	in := tweening.NewBounceEquation(api.EaseIn)
+	out := tweening.NewBounceEquation(api.EaseOut)
+	if math.Abs(in.Compute(0.3)-(1.0-out.Compute(0.7))) > epsilon {
+		t.Fatal("Expected Bounce in and out to mirror")
+	}
+}
+
+func runBezier(t *testing.T) {
+	linear := tweening.NewBezierEquation(0.0, 0.0, 1.0, 1.0)
+	for x := 0.0; x <= 1.0; x += 0.1 {
+		if v := linear.Compute(x); math.Abs(v-x) > epsilon {
+			t.Fatalf("Expected linear curve, got %f at %f", v, x)
+		}
+	}
+
+	// CSS's "ease" curve
+	ease := tweening.NewBezierEquation(0.25, 0.1, 0.25, 1.0)
+	if v := ease.Compute(0.5); math.Abs(v-0.8024033877399112) > 1e-4 {
+		t.Fatalf("Expected ease to be 0.8024 half way, got %f", v)
+	}
+
+	// Steep curves fall back to bisection.
+	steep := tweening.NewBezierEquation(1.0, 0.0, 1.0, 0.0)
+	if v := steep.Compute(0.99); v < 0.0 || v > 1.0 {
+		t.Fatalf("Expected a value within the curve, got %f", v)
+	}
+}
+
+func expect(t *testing.T, tween api.ITween, dt, value float64, finished bool) {
+	v, f := tween.Update(dt)
+	if math.Abs(v-value) > epsilon || f != finished {
+		t.Fatalf("Expected (%0.2f, %v), got (%0.2f, %v) at %0.0fms", value, finished, v, f, tween.Elapsed())
+	}
+}
+
+func runTween(t *testing.T) {
+	tween := tweening.NewTween(0.0, 100.0, 100.0, api.EquationLinear, api.EaseNoMeaning)
+	tween.SetDelay(50.0)
+
+	expect(t, tween, 25.0, 0.0, false)
+	expect(t, tween, 50.0, 25.0, false)
+	expect(t, tween, 75.0, 100.0, true)
+
+	// Two repeats playing backwards every other time
+	tween = tweening.NewTween(0.0, 100.0, 100.0, api.EquationLinear, api.EaseNoMeaning)
+	tween.SetRepeat(2)
+	tween.SetYoyo(true)
+
+	expect(t, tween, 50.0, 50.0, false)
+	expect(t, tween, 75.0, 75.0, false)
+	expect(t, tween, 100.0, 25.0, false)
+	expect(t, tween, 100.0, 100.0, true)
+
+	// A yoyo with an odd number of repeats ends where it began
+	tween = tweening.NewTween(0.0, 100.0, 100.0, api.EquationLinear, api.EaseNoMeaning)
+	tween.SetRepeat(1)
+	tween.SetYoyo(true)
+	expect(t, tween, 500.0, 0.0, true)
+
+	tween.Reset()
+	expect(t, tween, 10.0, 10.0, false)
+
+	// Forever never finishes
+	tween = tweening.NewTween(0.0, 100.0, 100.0, api.EquationLinear, api.EaseNoMeaning)
+	tween.SetRepeat(api.RepeatForever)
+	expect(t, tween, 10050.0, 50.0, false)
+}
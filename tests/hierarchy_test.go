@@ -0,0 +1,118 @@
+package hierarchy
+
+import (
+	"testing"
+
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine"
+	"github.com/wdevore/RangerGo/engine/nodes"
+)
+
+// probe counts its lifecycle calls
+type probe struct {
+	nodes.Node
+
+	entered, exited int
+}
+
+func newProbe(name string, world api.IWorld) *probe {
+	o := new(probe)
+	o.Initialize(name)
+	o.Build(world)
+	return o
+}
+
+func (p *probe) EnterNode(man api.INodeManager) { p.entered++ }
+func (p *probe) ExitNode(man api.INodeManager)  { p.exited++ }
+
+type scene struct {
+	probe
+	nodes.Scene
+}
+
+func (s *scene) TransitionAction() int {
+	return api.SceneNoAction
+}
+
+func TestRunner(t *testing.T) {
+	world := engine.NewWorld("Hierarchy", 1.0, "../examples")
+
+	runGroup(t, world)
+	runLifecycles(t, world)
+}
+
+func runGroup(t *testing.T, world api.IWorld) {
+	parent := newProbe("Parent", world)
+	a := newProbe("A", world)
+	b := newProbe("B", world)
+	c := newProbe("C", world)
+
+	nodes.Attach(parent, a)
+	nodes.Attach(parent, c)
+	nodes.AttachAt(parent, b, 1)
+
+	if parent.IndexOf(b) != 1 || b.Parent() != api.INode(parent) {
+		t.Fatalf("Expected B inserted at 1 with its parent set, got %d", parent.IndexOf(b))
+	}
+
+	if parent.FindByName("C") != api.INode(c) || parent.FindByID(a.ID()) != api.INode(a) {
+		t.Fatal("Expected to find children by name and id")
+	}
+
+	// Reparenting moves the child and marks it dirty.
+	other := newProbe("Other", world)
+	b.SetDirty(false)
+	nodes.Attach(other, b)
+
+	if parent.IndexOf(b) != -1 || other.IndexOf(b) != 0 || b.Parent() != api.INode(other) {
+		t.Fatal("Expected B to be moved to Other")
+	}
+
+	if !b.IsDirty() {
+		t.Fatal("Expected B to be dirty after reparenting")
+	}
+
+	if !parent.RemoveChild(a) || a.HasParent() || parent.RemoveChild(a) {
+		t.Fatal("Expected A to be removed once")
+	}
+}
+
+func runLifecycles(t *testing.T, world api.IWorld) {
+	s := new(scene)
+	s.Initialize("Scene")
+	s.Build(world)
+
+	manager := nodes.NewNodeManager(world)
+	manager.PushNode(s)
+	manager.Visit(1.0)
+
+	if s.entered != 1 {
+		t.Fatalf("Expected the scene to enter once, got %d", s.entered)
+	}
+
+	// Spawning into the running scene enters the whole subtree.
+	spawn := newProbe("Spawn", world)
+	child := newProbe("Child", world)
+	nodes.Attach(spawn, child)
+	nodes.Attach(s, spawn)
+
+	if spawn.entered != 1 || child.entered != 1 {
+		t.Fatalf("Expected spawned nodes to enter, got %d and %d", spawn.entered, child.entered)
+	}
+
+	// Moving within the scene doesn't re-enter.
+	nodes.Attach(s, child)
+	if child.entered != 1 || child.exited != 0 {
+		t.Fatal("Expected no lifecycle calls when moving within the scene")
+	}
+
+	nodes.Detach(spawn)
+	if spawn.exited != 1 || child.exited != 0 {
+		t.Fatalf("Expected only Spawn to exit, got %d and %d", spawn.exited, child.exited)
+	}
+
+	manager.End()
+	if s.exited != 1 || child.exited != 1 {
+		t.Fatalf("Expected the scene and child to exit once, got %d and %d", s.exited, child.exited)
+	}
+}
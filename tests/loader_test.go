@@ -0,0 +1,162 @@
+package loader
+
+import (
+	"testing"
+	"time"
+
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine"
+	"github.com/wdevore/RangerGo/engine/misc"
+	"github.com/wdevore/RangerGo/engine/nodes"
+)
+
+// listener records the loader's notifications
+type listener struct {
+	progress  float64
+	completed int
+	scene     api.INode
+}
+
+func (l *listener) LoadProgress(fraction float64) {
+	l.progress = fraction
+}
+
+func (l *listener) LoadComplete(scene api.INode) {
+	l.completed++
+	l.scene = scene
+}
+
+func TestRunner(t *testing.T) {
+	runLoader(t)
+	runStop(t)
+	runPanic(t)
+}
+
+func runLoader(t *testing.T) {
+	world := engine.NewWorld("Loader", 1.0, "../examples")
+
+	// The builder blocks on its Call until the test goroutine polls.
+	release := make(chan bool)
+	var called bool
+
+	builder := func(world api.IWorld, loader api.ILoader) api.INode {
+		root := nodes.NewNode()
+		root.Initialize("Root")
+		root.Build(world)
+
+		loader.SetProgress(0.5)
+		<-release
+
+		loader.Call(func() { called = true })
+
+		return root
+	}
+
+	loader := misc.NewLoader(world, builder)
+	l := &listener{}
+	loader.SetListener(l)
+	loader.Start()
+
+	// Starting twice doesn't run the builder again.
+	loader.Start()
+
+	waitFor(t, func() bool { return loader.Progress() == 0.5 })
+
+	loader.Poll()
+	if l.progress != 0.5 {
+		t.Fatalf("Expected progress 0.5, got %0.2f", l.progress)
+	}
+	if loader.IsDone() || l.completed != 0 {
+		t.Fatal("Expected loader to be in progress")
+	}
+
+	close(release)
+
+	// Calls only run when polled.
+	waitFor(t, func() bool {
+		loader.Poll()
+		return loader.IsDone()
+	})
+
+	if !called {
+		t.Fatal("Expected Call to run on the polling goroutine")
+	}
+
+	loader.Poll()
+	loader.Poll()
+
+	if l.completed != 1 {
+		t.Fatalf("Expected one completion, got %d", l.completed)
+	}
+	if l.scene == nil || l.scene.Name() != "Root" || loader.Scene() != l.scene {
+		t.Fatal("Expected the built scene")
+	}
+	if l.progress != 1.0 {
+		t.Fatalf("Expected progress 1.0, got %0.2f", l.progress)
+	}
+}
+
+func runStop(t *testing.T) {
+	world := engine.NewWorld("Loader", 1.0, "../examples")
+
+	// Nothing polls, so the builder blocks in Call until stopped.
+	result := make(chan bool)
+	ran := false
+
+	loader := misc.NewLoader(world, func(world api.IWorld, loader api.ILoader) api.INode {
+		result <- loader.Call(func() { ran = true })
+		return nil
+	})
+	l := &listener{}
+	loader.SetListener(l)
+	loader.Start()
+
+	loader.Stop()
+
+	select {
+	case called := <-result:
+		if called || ran {
+			t.Fatal("Expected the stopped Call not to run")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Expected Stop to release the builder")
+	}
+
+	if !loader.IsStopped() {
+		t.Fatal("Expected the loader to be stopped")
+	}
+
+	waitFor(t, loader.IsDone)
+	loader.Poll()
+	if l.completed != 0 {
+		t.Fatal("Expected no notifications once stopped")
+	}
+}
+
+func runPanic(t *testing.T) {
+	world := engine.NewWorld("Loader", 1.0, "../examples")
+
+	loader := misc.NewLoader(world, func(world api.IWorld, loader api.ILoader) api.INode {
+		panic("missing level")
+	})
+	l := &listener{}
+	loader.SetListener(l)
+	loader.Start()
+
+	waitFor(t, loader.IsDone)
+	loader.Poll()
+
+	if loader.Err() == nil || l.completed != 1 || l.scene != nil {
+		t.Fatalf("Expected a failed load, got %v", loader.Err())
+	}
+}
+
+func waitFor(t *testing.T, condition func() bool) {
+	for i := 0; i < 1000; i++ {
+		if condition() {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatal("Timed out waiting for the loader")
+}
@@ -0,0 +1,161 @@
+package motion
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine"
+	"github.com/wdevore/RangerGo/engine/animation"
+	"github.com/wdevore/RangerGo/engine/maths"
+	"github.com/wdevore/RangerGo/engine/nodes"
+	"github.com/wdevore/RangerGo/engine/rendering"
+)
+
+type scene struct {
+	nodes.Node
+	nodes.Scene
+}
+
+func (s *scene) TransitionAction() int {
+	return api.SceneNoAction
+}
+
+func TestRunner(t *testing.T) {
+	runLinear(t)
+	runAngular(t)
+	runLinear2D(t)
+	runColor(t)
+	runBound(t)
+}
+
+func expect(t *testing.T, what string, got, want float64) {
+	if math.Abs(got-want) > 1e-9 {
+		t.Fatalf("Expected %s %0.3f, got %0.3f", what, want, got)
+	}
+}
+
+func runLinear(t *testing.T) {
+	m := animation.NewLinearMotion()
+	m.SetRate(10.0)
+	m.Set(1.0, 1.0)
+
+	m.Update(500.0)
+	expect(t, "start", m.Interpolate(0.0).(float64), 1.0)
+	expect(t, "middle", m.Interpolate(0.5).(float64), 3.5)
+	expect(t, "end", m.Interpolate(1.0).(float64), 6.0)
+}
+
+func runAngular(t *testing.T) {
+	m := animation.NewAngularMotion()
+
+	// 350° to 10° passes through 0°, not 180°.
+	m.Set(maths.DegreeToRadians*350.0, maths.DegreeToRadians*10.0)
+	expect(t, "wrapped", m.Interpolate(0.5).(float64), 0.0)
+	expect(t, "quarter", m.Interpolate(0.25).(float64), maths.DegreeToRadians*355.0)
+
+	// Rates are radians per second and wrap at 2π.
+	m.SetRate(maths.DegreeToRadians * 90.0)
+	m.Set(maths.DegreeToRadians*315.0, maths.DegreeToRadians*315.0)
+	m.Update(1000.0)
+	expect(t, "crossing", m.Interpolate(0.5).(float64), maths.DegreeToRadians*0.0)
+	expect(t, "crossed", m.Interpolate(1.0).(float64), maths.DegreeToRadians*45.0)
+
+	m.Update(1000.0)
+	expect(t, "from", m.Interpolate(0.0).(float64), maths.DegreeToRadians*45.0)
+	expect(t, "to", m.Interpolate(1.0).(float64), maths.DegreeToRadians*135.0)
+
+	// Without wrapping the angle keeps growing.
+	m.SetAutoWrap(false)
+	m.Set(maths.DegreeToRadians*350.0, maths.DegreeToRadians*10.0)
+	expect(t, "unwrapped", m.Interpolate(0.5).(float64), maths.DegreeToRadians*180.0)
+}
+
+func runLinear2D(t *testing.T) {
+	m := animation.NewLinear2DMotion()
+	m.SetRate(100.0)
+	m.(*animation.Linear2DMotion).SetDirection(3.0, 4.0)
+
+	start := maths.NewVectorUsing(10.0, 20.0)
+	m.Set(start, start)
+
+	m.Update(1000.0)
+	m.Update(500.0)
+	p := m.Interpolate(0.5).(api.IVector)
+	expect(t, "x", p.X(), 10.0+60.0+15.0)
+	expect(t, "y", p.Y(), 20.0+80.0+20.0)
+
+	// The motion keeps its own copies.
+	if start.X() != 10.0 || start.Y() != 20.0 {
+		t.Fatal("Expected the start vector to be unchanged")
+	}
+}
+
+func runColor(t *testing.T) {
+	m := animation.NewColorMotion()
+	m.SetRate(0.5)
+	m.SetAutoWrap(false)
+	m.Set(rendering.NewPaletteInt64(0x000000ff), rendering.NewPaletteInt64(0xc864ff7f))
+
+	m.Update(1000.0)
+	c := m.Interpolate(1.0).(api.IPalette)
+	if c.R() != 100 || c.G() != 50 || c.B() != 128 || c.A() != 191 {
+		t.Fatalf("Expected half way color, got %v", c.Color())
+	}
+
+	// Without wrapping it holds at the end color.
+	m.Update(1000.0)
+	m.Update(1000.0)
+	c = m.Interpolate(0.0).(api.IPalette)
+	if c.AsUInt64() != 0xc864ff7f {
+		t.Fatalf("Expected the end color, got %v", c.Color())
+	}
+}
+
+func runBound(t *testing.T) {
+	world := engine.NewWorld("Motion", 1.0, "../examples")
+	manager := nodes.NewNodeManager(world)
+
+	s := new(scene)
+	s.Initialize("Scene")
+	s.Build(world)
+
+	node := nodes.NewNode()
+	node.Initialize("Node")
+	node.Build(world)
+	nodes.Attach(s, node)
+
+	spin := animation.NewAngularMotion()
+	spin.SetRate(1.0)
+	node.AddMotion(spin, animation.ApplyRotation(node))
+
+	manager.PushNode(s)
+	manager.Visit(0.5)
+
+	// The manager updates bound motions and Interpolate applies them.
+	manager.Update(1000.0, 1.0)
+	node.Interpolate(0.5)
+	expect(t, "rotation", node.Rotation(), 0.5)
+
+	// Motions added while on stage are updated too.
+	grow := animation.NewLinearMotion()
+	grow.SetRate(2.0)
+	grow.Set(1.0, 1.0)
+	node.AddMotion(grow, animation.ApplyScale(node))
+	manager.Update(1000.0, 1.0)
+	node.Interpolate(1.0)
+	expect(t, "rotation", node.Rotation(), 2.0)
+	expect(t, "scale", node.Scale(), 3.0)
+
+	node.RemoveMotion(spin)
+	manager.Update(1000.0, 1.0)
+	node.Interpolate(1.0)
+	expect(t, "rotation", node.Rotation(), 2.0)
+	expect(t, "scale", node.Scale(), 5.0)
+
+	// Exiting the stage stops the updates.
+	manager.End()
+	manager.Update(1000.0, 1.0)
+	node.Interpolate(1.0)
+	expect(t, "scale", node.Scale(), 5.0)
+}
@@ -0,0 +1,181 @@
+package navigation
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine/ai/navigation"
+	"github.com/wdevore/RangerGo/engine/geometry"
+)
+
+func TestRunner(t *testing.T) {
+	runGrid(t)
+	runGridCosts(t)
+	runNavMesh(t)
+}
+
+func expectPoint(t *testing.T, what string, p api.IPoint, x, y float64) {
+	if math.Abs(p.X()-x) > 1e-6 || math.Abs(p.Y()-y) > 1e-6 {
+		t.Fatalf("Expected %s (%0.2f, %0.2f), got %v", what, x, y, p)
+	}
+}
+
+func rectangle(minX, minY, maxX, maxY float64) api.IPolygon {
+	p := geometry.NewPolygon()
+	p.AddVertex(minX, minY)
+	p.AddVertex(maxX, minY)
+	p.AddVertex(maxX, maxY)
+	p.AddVertex(minX, maxY)
+	p.Build()
+	return p
+}
+
+func runGrid(t *testing.T) {
+	// A wall down column 2 with a gap at the bottom row
+	grid := navigation.NewGrid(5, 5, 10.0)
+	for row := 0; row < 4; row++ {
+		grid.SetBlocked(2, row, true)
+	}
+
+	points, found := grid.FindPath(5.0, 5.0, 45.0, 5.0)
+	if !found {
+		t.Fatal("Expected a path")
+	}
+	expectPoint(t, "start", points[0], 5.0, 5.0)
+	expectPoint(t, "end", points[len(points)-1], 45.0, 5.0)
+
+	// The path turns through the gap.
+	gap := false
+	for _, p := range points {
+		col, row, _ := grid.CellAt(p.X(), p.Y())
+		if grid.IsBlocked(col, row) {
+			t.Fatalf("Expected open cells, got %v", p)
+		}
+		gap = gap || row == 4
+	}
+	if !gap {
+		t.Fatalf("Expected the path through the gap, got %v", points)
+	}
+
+	// Closing the gap leaves no path.
+	grid.SetBlocked(2, 4, true)
+	if _, found := grid.FindPath(5.0, 5.0, 45.0, 5.0); found {
+		t.Fatal("Expected no path")
+	}
+
+	// A diagonal step between two blocked cells needs DiagonalAlways.
+	grid = navigation.NewGrid(2, 2, 10.0)
+	grid.SetBlocked(1, 0, true)
+	grid.SetBlocked(0, 1, true)
+	if _, found := grid.FindPath(5.0, 5.0, 15.0, 15.0); found {
+		t.Fatal("Expected no path without diagonals")
+	}
+	grid.SetDiagonal(api.DiagonalAlways)
+	if points, found := grid.FindPath(5.0, 5.0, 15.0, 15.0); !found || len(points) != 2 {
+		t.Fatal("Expected a diagonal path")
+	}
+}
+
+func runGridCosts(t *testing.T) {
+	// A costly middle row is crossed where it is cheap.
+	grid := navigation.NewGrid(5, 3, 10.0)
+	grid.SetDiagonal(api.DiagonalNever)
+	for col := 0; col < 4; col++ {
+		grid.SetCost(col, 1, 10.0)
+	}
+
+	points, found := grid.FindPath(5.0, 5.0, 5.0, 25.0)
+	if !found {
+		t.Fatal("Expected a path")
+	}
+	expectPoint(t, "detour", points[1], 45.0, 5.0)
+
+	path := navigation.ToPath(points)
+	if math.Abs(path.Length()-100.0) > 1e-6 {
+		t.Fatalf("Expected a path length of 100, got %0.3f", path.Length())
+	}
+}
+
+func runNavMesh(t *testing.T) {
+	// A wall in the middle of a room
+	room := rectangle(0.0, 0.0, 100.0, 100.0)
+	wall := rectangle(40.0, 20.0, 60.0, 100.0)
+	_, err := navigation.NewNavMesh(room, wall)
+	if err == nil {
+		t.Fatal("Expected an error for an obstacle touching the boundary")
+	}
+
+	wall = rectangle(40.0, 20.0, 60.0, 90.0)
+	mesh, err := navigation.NewNavMesh(room, wall)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The funnel hugs the wall's corners.
+	points, found := mesh.FindPath(20.0, 80.0, 80.0, 80.0)
+	if !found {
+		t.Fatal("Expected a path")
+	}
+	if len(points) != 4 {
+		t.Fatalf("Expected 4 points, got %v", points)
+	}
+	expectPoint(t, "first corner", points[1], 40.0, 90.0)
+	expectPoint(t, "second corner", points[2], 60.0, 90.0)
+
+	// Visible points are joined directly.
+	points, _ = mesh.FindPath(10.0, 10.0, 90.0, 10.0)
+	if len(points) != 2 {
+		t.Fatalf("Expected a straight path, got %v", points)
+	}
+
+	// Points inside obstacles aren't on the mesh.
+	if _, found := mesh.FindPath(50.0, 50.0, 90.0, 10.0); found {
+		t.Fatal("Expected no path from inside the wall")
+	}
+
+	// Several obstacles
+	rocks := []api.IPolygon{
+		rectangle(10.0, 10.0, 30.0, 30.0),
+		rectangle(70.0, 10.0, 90.0, 30.0),
+		rectangle(10.0, 70.0, 30.0, 90.0),
+		rectangle(70.0, 70.0, 90.0, 90.0),
+		rectangle(45.0, 45.0, 55.0, 55.0),
+	}
+	mesh, err = navigation.NewNavMesh(room, rocks...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, found := mesh.FindPath(5.0, 5.0, 95.0, 95.0); !found {
+		t.Fatal("Expected a path between the rocks")
+	}
+	if len(mesh.Edges()) == 0 {
+		t.Fatal("Expected edges")
+	}
+
+	// A cup opening away from the goal. Heading toward the goal leads
+	// into the cup's bottom so the cheaper corridor leaves by the mouth
+	// and goes over the top.
+	cup := geometry.NewPolygon()
+	for _, v := range [][2]float64{{20, 20}, {80, 20}, {80, 80}, {20, 80}, {20, 70}, {70, 70}, {70, 30}, {20, 30}} {
+		cup.AddVertex(v[0], v[1])
+	}
+	cup.Build()
+	mesh, err = navigation.NewNavMesh(room, cup)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	points, found = mesh.FindPath(60.0, 55.0, 90.0, 50.0)
+	if !found {
+		t.Fatal("Expected a path out of the cup")
+	}
+	length := 0.0
+	for i := 1; i < len(points); i++ {
+		length += math.Hypot(points[i].X()-points[i-1].X(), points[i].Y()-points[i-1].Y())
+	}
+	expected := math.Hypot(40.0, 15.0) + 10.0 + 60.0 + math.Hypot(10.0, 30.0)
+	if math.Abs(length-expected) > 1e-6 {
+		t.Fatalf("Expected the path over the top of length %0.2f, got %0.2f: %v", expected, length, points)
+	}
+}
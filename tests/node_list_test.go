@@ -0,0 +1,99 @@
+package nodelist
+
+import (
+	"testing"
+
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine"
+	"github.com/wdevore/RangerGo/engine/nodes"
+)
+
+// target unregisters itself, and registers a replacement, on its first update
+type target struct {
+	nodes.Node
+
+	manager     api.INodeManager
+	replacement api.INode
+	updates     int
+}
+
+func newTarget(name string, world api.IWorld) *target {
+	o := new(target)
+	o.Initialize(name)
+	o.Build(world)
+	return o
+}
+
+func (t *target) Update(msPerUpdate, secPerUpdate float64) {
+	t.updates++
+
+	if t.manager != nil {
+		t.manager.UnRegisterTarget(t)
+		t.manager.RegisterTarget(t.replacement)
+		t.manager = nil
+	}
+}
+
+func TestRunner(t *testing.T) {
+	world := engine.NewWorld("NodeList", 1.0, "../examples")
+
+	runList(t, world)
+	runDeferred(t, world)
+}
+
+func runList(t *testing.T, world api.IWorld) {
+	list := nodes.NewNodeList()
+	a := newTarget("A", world)
+	b := newTarget("B", world)
+
+	list.Add(a)
+	list.Add(b)
+	list.Add(a)
+	if len(list.Items()) != 2 {
+		t.Fatalf("Expected duplicates to be ignored, got %d items", len(list.Items()))
+	}
+
+	list.Remove(a)
+	if len(list.Items()) != 1 || list.Items()[0] != api.INode(b) {
+		t.Fatalf("Expected the list to shrink to B, got %v", list.Items())
+	}
+
+	// Changes while locked are deferred.
+	list.Lock()
+	list.Remove(b)
+	list.Add(a)
+	if len(list.Items()) != 1 || list.Items()[0] != nil {
+		t.Fatalf("Expected B to be nil while locked, got %v", list.Items())
+	}
+
+	list.Unlock()
+	if len(list.Items()) != 1 || list.Items()[0] != api.INode(a) {
+		t.Fatalf("Expected only A after unlocking, got %v", list.Items())
+	}
+}
+
+func runDeferred(t *testing.T, world api.IWorld) {
+	manager := nodes.NewNodeManager(world)
+
+	a := newTarget("A", world)
+	b := newTarget("B", world)
+	c := newTarget("C", world)
+
+	a.manager = manager
+	a.replacement = c
+
+	manager.RegisterTarget(a)
+	manager.RegisterTarget(b)
+
+	// A unregisters itself mid-update; B must still update and C waits
+	// for the next update.
+	manager.Update(16.0, 0.016)
+	if a.updates != 1 || b.updates != 1 || c.updates != 0 {
+		t.Fatalf("Expected updates 1,1,0 got %d,%d,%d", a.updates, b.updates, c.updates)
+	}
+
+	manager.Update(16.0, 0.016)
+	if a.updates != 1 || b.updates != 2 || c.updates != 1 {
+		t.Fatalf("Expected updates 1,2,1 got %d,%d,%d", a.updates, b.updates, c.updates)
+	}
+}
@@ -0,0 +1,184 @@
+package noderegistry
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine"
+	"github.com/wdevore/RangerGo/engine/nodes"
+	"github.com/wdevore/RangerGo/engine/nodes/custom"
+)
+
+type scene struct {
+	nodes.Node
+	nodes.Scene
+}
+
+func (s *scene) TransitionAction() int {
+	return api.SceneNoAction
+}
+
+func TestRunner(t *testing.T) {
+	runConcurrentIDs(t)
+	runRegistry(t)
+	runBuiltBeforeInitialized(t)
+	runReservedIDs(t)
+}
+
+func runConcurrentIDs(t *testing.T) {
+	world := engine.NewWorld("Registry", 1.0, "../examples")
+	other := engine.NewWorld("Other", 1.0, "../examples")
+
+	const builders = 8
+	const count = 100
+
+	ids := make(chan int, builders*count)
+	wg := sync.WaitGroup{}
+
+	for b := 0; b < builders; b++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := 0; i < count; i++ {
+				n := nodes.NewNode()
+				n.Initialize("Node")
+				n.Build(world)
+				ids <- n.ID()
+			}
+		}()
+	}
+
+	wg.Wait()
+	close(ids)
+
+	seen := map[int]bool{}
+	for id := range ids {
+		if seen[id] {
+			t.Fatalf("Expected unique ids, %d allocated twice", id)
+		}
+		seen[id] = true
+	}
+
+	// Worlds allocate independently.
+	n := nodes.NewNode()
+	n.Initialize("Other")
+	n.Build(other)
+	if n.ID() != 0 {
+		t.Fatalf("Expected the other world's first id to be 0, got %d", n.ID())
+	}
+}
+
+func runRegistry(t *testing.T) {
+	world := engine.NewWorld("Registry", 1.0, "../examples")
+	registry := world.NodeRegistry()
+
+	s := new(scene)
+	s.Initialize("Scene")
+	s.Build(world)
+
+	child := nodes.NewNode()
+	child.Initialize("Child")
+	child.Build(world)
+	nodes.Attach(s, child)
+
+	manager := nodes.NewNodeManager(world)
+	manager.PushNode(s)
+	manager.Visit(1.0)
+
+	if registry.Lookup(child.ID()) != child {
+		t.Fatal("Expected the running child to be registered")
+	}
+
+	// A node with a taken id is rejected.
+	impostor := nodes.NewNode()
+	impostor.Initialize("Impostor")
+	impostor.Build(world)
+	impostor.SetID(child.ID())
+	if registry.Register(impostor) {
+		t.Fatal("Expected a collision")
+	}
+
+	// Changing a running node's id moves its registration.
+	child.SetID(1000)
+	if registry.Lookup(1000) != child {
+		t.Fatal("Expected the registration to follow SetID")
+	}
+
+	nodes.Detach(child)
+	if registry.Lookup(1000) != nil {
+		t.Fatal("Expected the detached child to be unregistered")
+	}
+}
+
+func runBuiltBeforeInitialized(t *testing.T) {
+	world := engine.NewWorld("Registry", 1.0, "../examples")
+
+	s := new(scene)
+	s.Initialize("Scene")
+	s.Build(world)
+
+	// Text nodes are built by their constructor and initialized after.
+	a := custom.NewVectorTextNode(world, s)
+	a.Initialize("A")
+	b := custom.NewVectorTextNode(world, s)
+	b.Initialize("B")
+
+	if a.ID() < 0 || b.ID() < 0 || a.ID() == b.ID() {
+		t.Fatalf("Expected unique allocated ids, got %d and %d", a.ID(), b.ID())
+	}
+
+	if s.FindByID(b.ID()) != b {
+		t.Fatal("Expected to find the text node by its id")
+	}
+}
+
+func runReservedIDs(t *testing.T) {
+	world := engine.NewWorld("Registry", 1.0, "../examples")
+	registry := world.NodeRegistry()
+
+	// Ids set before a node enters the stage aren't allocated again.
+	a := nodes.NewNode()
+	a.Initialize("A")
+	a.Build(world)
+	a.SetID(a.ID() + 1)
+
+	b := nodes.NewNode()
+	b.InitializeWithID(a.ID()+1, "B")
+	b.Build(world)
+
+	c := nodes.NewNode()
+	c.Initialize("C")
+	c.Build(world)
+	if c.ID() == a.ID() || c.ID() == b.ID() {
+		t.Fatalf("Expected an unreserved id, got %d", c.ID())
+	}
+
+	// Colliding nodes entering the stage are both registered.
+	s := new(scene)
+	s.Initialize("Scene")
+	s.Build(world)
+
+	first := nodes.NewNode()
+	first.Initialize("First")
+	first.Build(world)
+	first.SetID(500)
+	nodes.Attach(s, first)
+
+	second := nodes.NewNode()
+	second.Initialize("Second")
+	second.Build(world)
+	second.SetID(500)
+	nodes.Attach(s, second)
+
+	manager := nodes.NewNodeManager(world)
+	manager.PushNode(s)
+	manager.Visit(1.0)
+
+	if first.ID() == second.ID() {
+		t.Fatalf("Expected the colliding node to be given a new id, got %d", second.ID())
+	}
+	if registry.Lookup(first.ID()) != first || registry.Lookup(second.ID()) != second {
+		t.Fatal("Expected both nodes to be findable by id")
+	}
+}
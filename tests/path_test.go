@@ -0,0 +1,135 @@
+package path
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wdevore/RangerGo/engine"
+	"github.com/wdevore/RangerGo/engine/animation/actions"
+	"github.com/wdevore/RangerGo/engine/geometry"
+	"github.com/wdevore/RangerGo/engine/maths"
+	"github.com/wdevore/RangerGo/engine/nodes"
+)
+
+func TestRunner(t *testing.T) {
+	runCatmullRom(t)
+	runBezier(t)
+	runBSpline(t)
+	runFollow(t)
+}
+
+func expect(t *testing.T, what string, got, want, tolerance float64) {
+	if math.Abs(got-want) > tolerance {
+		t.Fatalf("Expected %s %0.3f, got %0.3f", what, want, got)
+	}
+}
+
+func runCatmullRom(t *testing.T) {
+	// Collinear points make a straight line.
+	path := geometry.NewCatmullRomPath()
+	path.AddPoint(0.0, 0.0)
+	path.AddPoint(100.0, 0.0)
+	path.AddPoint(200.0, 0.0)
+	path.Build()
+
+	expect(t, "length", path.Length(), 200.0, 1e-6)
+
+	p := geometry.NewPoint()
+	path.PointAt(50.0, p)
+	// The arc-length table is sampled, so positions are approximate.
+	expect(t, "x", p.X(), 50.0, 0.1)
+
+	// Distances are clamped on open paths.
+	path.PointAt(500.0, p)
+	expect(t, "end x", p.X(), 200.0, 1e-6)
+
+	// Closed paths pass through every point and wrap.
+	path = geometry.NewCatmullRomPath()
+	path.AddPoint(0.0, 0.0)
+	path.AddPoint(100.0, 0.0)
+	path.AddPoint(100.0, 100.0)
+	path.AddPoint(0.0, 100.0)
+	path.SetClosed(true)
+	path.Build()
+
+	// The square's corners are at quarter lengths by symmetry.
+	path.PointAt(path.Length()*0.25, p)
+	expect(t, "corner x", p.X(), 100.0, 1e-3)
+	expect(t, "corner y", p.Y(), 0.0, 1e-3)
+
+	path.PointAt(path.Length()*1.5, p)
+	expect(t, "wrapped x", p.X(), 100.0, 1e-3)
+	expect(t, "wrapped y", p.Y(), 100.0, 1e-3)
+
+	tangent := maths.NewVector()
+	path.TangentAt(path.Length()*0.125, tangent)
+	expect(t, "tangent length", tangent.Length(), 1.0, 1e-9)
+}
+
+func runBezier(t *testing.T) {
+	// A quarter circle of radius 100 is about 157.08 long.
+	k := 0.5522847498 * 100.0
+	path := geometry.NewBezierPath()
+	path.AddPoint(100.0, 0.0)
+	path.AddPoint(100.0, k)
+	path.AddPoint(k, 100.0)
+	path.AddPoint(0.0, 100.0)
+	path.Build()
+
+	expect(t, "length", path.Length(), math.Pi*50.0, 0.1)
+
+	// Arc-length makes half the length the middle of the arc.
+	p := geometry.NewPoint()
+	path.PointAt(path.Length()/2.0, p)
+	expect(t, "middle x", p.X(), 100.0*math.Cos(math.Pi/4.0), 0.1)
+	expect(t, "middle y", p.Y(), 100.0*math.Sin(math.Pi/4.0), 0.1)
+}
+
+func runBSpline(t *testing.T) {
+	path := geometry.NewBSplinePath()
+	path.AddPoint(0.0, 0.0)
+	path.AddPoint(100.0, 100.0)
+	path.AddPoint(200.0, 0.0)
+	path.Build()
+
+	// Open B-splines end at their end points.
+	p := geometry.NewPoint()
+	path.PointAt(0.0, p)
+	expect(t, "start x", p.X(), 0.0, 1e-9)
+	path.PointAt(path.Length(), p)
+	expect(t, "end x", p.X(), 200.0, 1e-9)
+
+	// The repeated end points don't stall the tangent.
+	tangent := maths.NewVector()
+	path.TangentAt(0.0, tangent)
+	expect(t, "tangent length", tangent.Length(), 1.0, 1e-9)
+}
+
+func runFollow(t *testing.T) {
+	world := engine.NewWorld("Path", 1.0, "../examples")
+
+	node := nodes.NewNode()
+	node.Initialize("Node")
+	node.Build(world)
+
+	path := geometry.NewCatmullRomPath()
+	path.AddPoint(0.0, 0.0)
+	path.AddPoint(0.0, 100.0)
+	path.AddPoint(0.0, 200.0)
+	path.Build()
+
+	// 200 units at 100 units/second
+	runner := actions.NewRunner()
+	runner.Run(node, actions.FollowPath(path, 100.0, true))
+
+	runner.Update(500.0)
+	expect(t, "y", node.Position().Y(), 50.0, 0.1)
+	expect(t, "rotation", node.Rotation(), math.Pi/2.0, 1e-6)
+
+	runner.Update(1500.0)
+	expect(t, "end y", node.Position().Y(), 200.0, 1e-6)
+
+	if runner.IsRunning(node) {
+		t.Fatal("Expected the follow to finish")
+	}
+}
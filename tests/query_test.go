@@ -0,0 +1,86 @@
+package query
+
+import (
+	"testing"
+
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine"
+	"github.com/wdevore/RangerGo/engine/nodes"
+)
+
+func TestRunner(t *testing.T) {
+	world := engine.NewWorld("Query", 1.0, "../examples")
+
+	root := newNode("Scene", world, nil)
+	layer := newNode("Game Layer", world, root)
+	ship := newNode("Ship", world, layer)
+	gun := newNode("Gun", world, ship)
+	enemy := newNode("Enemy", world, layer)
+
+	ship.AddTag("player")
+	enemy.AddTag("enemy")
+	gun.AddTag("enemy")
+	gun.RemoveTag("enemy")
+	gun.AddTag("weapon")
+	gun.AddTag("weapon")
+
+	runTags(t, gun)
+	runFinds(t, root, gun, enemy)
+	runPaths(t, root, layer, ship, gun)
+}
+
+func newNode(name string, world api.IWorld, parent api.INode) api.INode {
+	n := nodes.NewNode()
+	n.Initialize(name)
+	n.Build(world)
+	if parent != nil {
+		nodes.Attach(parent, n)
+	}
+	return n
+}
+
+func runTags(t *testing.T, gun api.INode) {
+	if len(gun.Tags()) != 1 || !gun.HasTag("weapon") || gun.HasTag("enemy") {
+		t.Fatalf("Expected only the weapon tag, got %v", gun.Tags())
+	}
+}
+
+func runFinds(t *testing.T, root, gun, enemy api.INode) {
+	if nodes.FindByName(root, "Gun") != gun {
+		t.Fatal("Expected to find Gun by name")
+	}
+
+	if nodes.FindByName(root, "Missing") != nil {
+		t.Fatal("Expected nil for a missing name")
+	}
+
+	enemies := nodes.FindByTag(root, "enemy")
+	if len(enemies) != 1 || enemies[0] != enemy {
+		t.Fatalf("Expected only Enemy to be tagged, got %v", enemies)
+	}
+
+	leaves := nodes.FindAll(root, func(node api.INode) bool {
+		return len(node.Children()) == 0
+	})
+	if len(leaves) != 2 || leaves[0] != gun || leaves[1] != enemy {
+		t.Fatalf("Expected Gun and Enemy as leaves, got %v", leaves)
+	}
+}
+
+func runPaths(t *testing.T, root, layer, ship, gun api.INode) {
+	if nodes.FindByPath(root, "Game Layer/Ship/Gun") != gun {
+		t.Fatal("Expected to find Gun by relative path")
+	}
+
+	if nodes.FindByPath(gun, "/Scene/Game Layer") != layer {
+		t.Fatal("Expected to find Game Layer by absolute path")
+	}
+
+	if nodes.FindByPath(gun, "../../Enemy") == nil {
+		t.Fatal("Expected to find Enemy via parents")
+	}
+
+	if nodes.FindByPath(ship, "Missing/Gun") != nil || nodes.FindByPath(gun, "/Other") != nil {
+		t.Fatal("Expected nil for missing paths")
+	}
+}
@@ -0,0 +1,109 @@
+package skeleton
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine"
+	"github.com/wdevore/RangerGo/engine/animation/skeleton"
+	"github.com/wdevore/RangerGo/engine/geometry"
+	"github.com/wdevore/RangerGo/engine/nodes"
+)
+
+func TestRunner(t *testing.T) {
+	world := engine.NewWorld("Skeleton", 1.0, "../examples")
+
+	runClips(t, world)
+	runIK(t, world)
+}
+
+func expect(t *testing.T, what string, got, want float64) {
+	if math.Abs(got-want) > 1e-6 {
+		t.Fatalf("Expected %s %0.3f, got %0.3f", what, want, got)
+	}
+}
+
+// arm builds a root with a two bone arm along the x-axis.
+func arm(world api.IWorld) (root api.INode, upper, lower api.IBone) {
+	root = nodes.NewNode()
+	root.Initialize("Root")
+	root.Build(world)
+
+	upper = skeleton.NewBone("Upper", world, root).(api.IBone)
+	upper.SetLength(100.0)
+
+	lower = skeleton.NewBone("Lower", world, upper).(api.IBone)
+	lower.SetRest(100.0, 0.0, 0.0)
+	lower.SetLength(100.0)
+
+	return root, upper, lower
+}
+
+func runClips(t *testing.T, world api.IWorld) {
+	root, upper, lower := arm(world)
+	upper.SetRest(0.0, 0.0, 1.0)
+
+	wave := skeleton.NewClip(1000.0)
+	wave.AddKey("Upper", 0.0, 0.0)
+	wave.AddKey("Upper", 500.0, 1.0)
+	wave.AddKey("Upper", 1000.0, 0.0)
+
+	reach := skeleton.NewClip(1000.0)
+	reach.AddKey("Lower", 0.0, 2.0)
+
+	s := skeleton.NewSkeleton(root)
+	if len(s.Bones()) != 2 || s.Bone("Lower") != lower {
+		t.Fatalf("Expected two bones, got %d", len(s.Bones()))
+	}
+
+	s.Play(wave)
+	s.Update(250.0)
+	expect(t, "upper", upper.Rotation(), 1.5)
+	expect(t, "lower", lower.Rotation(), 0.0)
+
+	// Clips loop by default.
+	s.Update(1000.0)
+	expect(t, "looped upper", upper.Rotation(), 1.5)
+
+	s.Blend(reach, 0.25)
+	s.Update(250.0)
+	expect(t, "blended upper", upper.Rotation(), 1.0+0.75*1.0)
+	expect(t, "blended lower", lower.Rotation(), 0.25*2.0)
+}
+
+func runIK(t *testing.T, world api.IWorld) {
+	root, upper, lower := arm(world)
+
+	target := nodes.NewNode()
+	target.Initialize("Target")
+	target.Build(world)
+	nodes.Attach(root, target)
+
+	ik := skeleton.NewTwoBoneIK(upper, lower)
+	ik.SetTarget(target)
+
+	s := skeleton.NewSkeleton(root)
+	s.AddIK(ik)
+
+	tip := geometry.NewPoint()
+	reach := func(x, y float64) {
+		target.SetPosition(x, y)
+		s.Update(10.0)
+		nodes.NodeToWorldTransform(lower, nil).TransformCompToPoint(lower.Length(), 0.0, tip)
+	}
+
+	reach(100.0, 100.0)
+	expect(t, "tip x", tip.X(), 100.0)
+	expect(t, "tip y", tip.Y(), 100.0)
+
+	ik.SetBendPositive(false)
+	reach(-50.0, 120.0)
+	expect(t, "tip x", tip.X(), -50.0)
+	expect(t, "tip y", tip.Y(), 120.0)
+
+	// Out of reach targets straighten the arm toward the target.
+	reach(0.0, -300.0)
+	expect(t, "straight x", tip.X(), 0.0)
+	expect(t, "straight y", tip.Y(), -200.0)
+}
@@ -0,0 +1,237 @@
+package steering
+
+import (
+	"math"
+	"testing"
+
+	"github.com/ByteArena/box2d"
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine"
+	"github.com/wdevore/RangerGo/engine/ai/steering"
+	"github.com/wdevore/RangerGo/engine/geometry"
+	"github.com/wdevore/RangerGo/engine/maths"
+	"github.com/wdevore/RangerGo/engine/nodes"
+)
+
+func TestRunner(t *testing.T) {
+	world := engine.NewWorld("Steering", 1.0, "../examples")
+
+	runSeekAndArrive(t, world)
+	runFlee(t, world)
+	runAvoid(t, world)
+	runFlock(t, world)
+	runCombinations(t, world)
+	runFollowPath(t, world)
+	runBody(t)
+}
+
+func newAgent(world api.IWorld, x, y float64) *steering.NodeAgent {
+	n := nodes.NewNode()
+	n.Initialize("Agent")
+	n.Build(world)
+	n.SetPosition(x, y)
+	return steering.NewNodeAgent(n, 100.0, 200.0)
+}
+
+func runSeekAndArrive(t *testing.T, world api.IWorld) {
+	agent := newAgent(world, 0.0, 0.0)
+	agent.SetOrient(true)
+	target := geometry.NewPointUsing(100.0, 0.0)
+
+	steering.Update(agent, steering.Seek(target), 0.1)
+	if agent.Position().X() <= 0.0 || agent.Velocity().Direction().X() != 1.0 {
+		t.Fatalf("Expected to move toward the target, at %v", agent.Position())
+	}
+
+	// Arriving comes to rest at the target.
+	arrive := steering.Arrive(target, 50.0)
+	for i := 0; i < 500; i++ {
+		steering.Update(agent, arrive, 0.02)
+	}
+	if math.Abs(agent.Position().X()-100.0) > 0.5 || agent.Velocity().Magnitude() > 1.0 {
+		t.Fatalf("Expected to arrive, at %v moving %0.3f", agent.Position(), agent.Velocity().Magnitude())
+	}
+
+	// Speed is limited to the max.
+	agent = newAgent(world, 0.0, 0.0)
+	for i := 0; i < 100; i++ {
+		steering.Update(agent, steering.Seek(geometry.NewPointUsing(10000.0, 0.0)), 0.1)
+	}
+	if agent.Velocity().Magnitude() > 100.0 {
+		t.Fatalf("Expected at most the max speed, got %0.3f", agent.Velocity().Magnitude())
+	}
+}
+
+func runFlee(t *testing.T, world api.IWorld) {
+	agent := newAgent(world, 0.0, 0.0)
+	force := maths.NewVector()
+
+	steering.Flee(geometry.NewPointUsing(10.0, 0.0), 50.0).Steer(agent, force)
+	if force.X() >= 0.0 {
+		t.Fatalf("Expected to flee, got %v", force)
+	}
+
+	// Outside the panic distance there is nothing to do.
+	steering.Flee(geometry.NewPointUsing(100.0, 0.0), 50.0).Steer(agent, force)
+	if force.Length() != 0.0 {
+		t.Fatalf("Expected no force, got %v", force)
+	}
+
+	// Evading flees from where the pursuer is heading.
+	pursuer := newAgent(world, 50.0, -50.0)
+	for i := 0; i < 10; i++ {
+		steering.Update(pursuer, steering.Seek(geometry.NewPointUsing(50.0, 1000.0)), 0.1)
+	}
+	steering.Evade(pursuer, 0.0).Steer(agent, force)
+	if force.Y() >= 0.0 {
+		t.Fatalf("Expected to evade upward, got %v", force)
+	}
+}
+
+func runAvoid(t *testing.T, world api.IWorld) {
+	agent := newAgent(world, 0.0, 0.0)
+	agent.SetRadius(10.0)
+	for i := 0; i < 10; i++ {
+		steering.Update(agent, steering.Seek(geometry.NewPointUsing(1000.0, 0.0)), 0.1)
+	}
+
+	// An obstacle ahead and slightly below pushes the agent up.
+	rock := geometry.NewCircle()
+	rock.SetCenter(150.0, 5.0)
+	rock.SetRadius(20.0)
+
+	force := maths.NewVector()
+	avoid := steering.AvoidObstacles([]*geometry.Circle{rock}, 2.0)
+	avoid.Steer(agent, force)
+	if force.Y() >= 0.0 {
+		t.Fatalf("Expected to steer up, got %v", force)
+	}
+
+	// Obstacles to the side are ignored.
+	rock.SetCenter(150.0, 100.0)
+	avoid.Steer(agent, force)
+	if force.Length() != 0.0 {
+		t.Fatalf("Expected no force, got %v", force)
+	}
+}
+
+func runFlock(t *testing.T, world api.IWorld) {
+	flock := steering.NewFlock()
+	a := newAgent(world, 0.0, 0.0)
+	b := newAgent(world, 10.0, 0.0)
+	c := newAgent(world, 500.0, 0.0)
+	flock.Add(a)
+	flock.Add(b)
+	flock.Add(c)
+
+	force := maths.NewVector()
+	steering.Separation(flock, 50.0).Steer(a, force)
+	if force.X() >= 0.0 {
+		t.Fatalf("Expected separation away from b, got %v", force)
+	}
+
+	steering.Cohesion(flock, 50.0).Steer(a, force)
+	if force.X() <= 0.0 {
+		t.Fatalf("Expected cohesion toward b, got %v", force)
+	}
+
+	// c has no neighbors.
+	steering.Alignment(flock, 50.0).Steer(c, force)
+	if force.Length() != 0.0 {
+		t.Fatalf("Expected no alignment, got %v", force)
+	}
+}
+
+func runCombinations(t *testing.T, world api.IWorld) {
+	agent := newAgent(world, 0.0, 0.0)
+	right := steering.Seek(geometry.NewPointUsing(100.0, 0.0))
+	down := steering.Seek(geometry.NewPointUsing(0.0, 100.0))
+	force := maths.NewVector()
+
+	// The sum is limited to the max force, 200.
+	steering.NewWeighted().Add(right, 1.0).Add(down, 0.5).Steer(agent, force)
+	if math.Abs(force.X()-2.0*force.Y()) > 1e-9 || math.Abs(force.Length()-200.0) > 1e-9 {
+		t.Fatalf("Expected a weighted sum, got %v", force)
+	}
+
+	// The first behaviour takes all the force.
+	steering.NewPrioritized().Add(right, 2.0).Add(down, 1.0).Steer(agent, force)
+	if force.X() != 200.0 || force.Y() != 0.0 {
+		t.Fatalf("Expected only the first priority, got %v", force)
+	}
+}
+
+func runFollowPath(t *testing.T, world api.IWorld) {
+	path := geometry.NewLinearPath()
+	path.AddPoint(0.0, 0.0)
+	path.AddPoint(10000.0, 0.0)
+	path.Build()
+
+	// Progress advances when looking less than a search step ahead.
+	for _, ahead := range []float64{0.0, 5.0, 50.0} {
+		agent := newAgent(world, 0.0, 0.0)
+		follow := steering.FollowPath(path, ahead)
+		for i := 0; i < 100; i++ {
+			steering.Update(agent, follow, 0.05)
+		}
+		if agent.Position().X() < 100.0 {
+			t.Fatalf("Ahead %0.1f: expected to follow the path, at %v", ahead, agent.Position())
+		}
+	}
+}
+
+// push steers with a constant force along x
+type push struct{}
+
+func (p push) Steer(agent api.ISteeringAgent, force api.IVector) {
+	force.SetByComp(1000.0, 0.0)
+}
+
+func runBody(t *testing.T) {
+	b2World := box2d.MakeB2World(box2d.MakeB2Vec2(0.0, 0.0))
+
+	bDef := box2d.MakeB2BodyDef()
+	bDef.Type = box2d.B2BodyType.B2_dynamicBody
+	body := b2World.CreateBody(&bDef)
+
+	shape := box2d.MakeB2CircleShape()
+	shape.SetRadius(1.0)
+	body.CreateFixture(&shape, 1.0)
+
+	agent := steering.NewBodyAgent(body, 5.0, 10.0, 1.0)
+	seek := steering.Seek(geometry.NewPointUsing(100.0, 0.0))
+
+	for i := 0; i < 60; i++ {
+		steering.Update(agent, seek, 1.0/60.0)
+		b2World.Step(1.0/60.0, 8, 3)
+	}
+
+	if agent.Position().X() <= 0.0 {
+		t.Fatalf("Expected the body to move toward the target, at %v", agent.Position())
+	}
+	if agent.Velocity().Magnitude() > 5.0+maths.Epsilon {
+		t.Fatalf("Expected at most the max speed, got %0.3f", agent.Velocity().Magnitude())
+	}
+
+	// The body itself stays under the cap after every step while a large
+	// force pushes it along.
+	agent = steering.NewBodyAgent(body, 5.0, 1000.0, 1.0)
+	for i := 0; i < 60; i++ {
+		steering.Update(agent, push{}, 1.0/60.0)
+		b2World.Step(1.0/60.0, 8, 3)
+
+		lv := body.GetLinearVelocity()
+		if speed := math.Hypot(lv.X, lv.Y); speed > 5.0+1e-6 {
+			t.Fatalf("Step %d: expected the body's speed at most 5, got %0.3f", i, speed)
+		}
+	}
+
+	// LimitSpeed caps other forces
+	body.ApplyLinearImpulse(box2d.MakeB2Vec2(0.0, 100.0*body.GetMass()), body.GetWorldCenter(), true)
+	b2World.Step(1.0/60.0, 8, 3)
+	agent.LimitSpeed()
+	lv := body.GetLinearVelocity()
+	if speed := math.Hypot(lv.X, lv.Y); speed > 5.0+1e-6 {
+		t.Fatalf("Expected LimitSpeed to cap the body, got %0.3f", speed)
+	}
+}
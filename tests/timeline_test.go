@@ -0,0 +1,188 @@
+package timeline
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine"
+	"github.com/wdevore/RangerGo/engine/animation/timeline"
+	"github.com/wdevore/RangerGo/engine/nodes"
+	"github.com/wdevore/RangerGo/engine/nodes/custom"
+)
+
+const data = `{
+  "timelines": {
+    "move": {
+      "tracks": [
+        {"node": "Ship", "property": "position", "keys": [
+          {"time": 0, "value": [0, 0]},
+          {"time": 100, "value": [100, 50]},
+          {"time": 200, "value": [0, 0], "ease": "quad-in"}
+        ]},
+        {"node": "Ship", "property": "visible", "keys": [
+          {"time": 0, "value": [1]},
+          {"time": 150, "value": [0], "ease": "step"}
+        ]},
+        {"node": "Ship/Box", "property": "color", "keys": [
+          {"time": 0, "value": [0, 0, 0, 255]},
+          {"time": 200, "value": [200, 100, 50, 255]}
+        ]}
+      ],
+      "markers": [{"time": 0, "name": "start"}, {"time": 100, "name": "turn"}]
+    },
+    "grow": {
+      "loop": true,
+      "duration": 100,
+      "tracks": [
+        {"node": "", "property": "scale", "keys": [
+          {"time": 0},
+          {"time": 50, "value": [3], "bezier": [0, 0, 1, 1]}
+        ]}
+      ],
+      "markers": [{"time": 0, "name": "loop"}]
+    }
+  }
+}`
+
+type listener struct {
+	markers []string
+}
+
+func (l *listener) MarkerReached(t api.ITimeline, marker string) {
+	l.markers = append(l.markers, marker)
+}
+
+func expect(t *testing.T, what string, got, want float64) {
+	if math.Abs(got-want) > 1e-9 {
+		t.Fatalf("Expected %s %0.3f, got %0.3f", what, want, got)
+	}
+}
+
+func TestRunner(t *testing.T) {
+	world := engine.NewWorld("Timeline", 1.0, "../examples")
+
+	timelines, err := timeline.Parse([]byte(data))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	root := nodes.NewNode()
+	root.Initialize("Root")
+	root.Build(world)
+
+	ship := nodes.NewNode()
+	ship.Initialize("Ship")
+	ship.Build(world)
+	nodes.Attach(root, ship)
+
+	box := custom.NewRectangleNode("Box", world, ship)
+
+	runTracks(t, timelines["move"], root, ship, box)
+	runLoop(t, timelines["grow"], ship)
+	runErrors(t, world)
+}
+
+func runTracks(t *testing.T, move *timeline.Timeline, root, ship, box api.INode) {
+	l := &listener{}
+	move.SetListener(l)
+	move.Bind(root)
+
+	expect(t, "duration", move.Duration(), 200.0)
+
+	shared := box.(api.IColorable).Color()
+	sharedColor := shared.AsUInt64()
+
+	// Scrubbing
+	move.Seek(50.0)
+	expect(t, "x", ship.Position().X(), 50.0)
+	expect(t, "y", ship.Position().Y(), 25.0)
+	if !ship.IsVisible() {
+		t.Fatal("Expected visible before the step")
+	}
+
+	move.Seek(150.0)
+	expect(t, "x", ship.Position().X(), 75.0) // 100 - 100 * 0.5^2
+	if ship.IsVisible() {
+		t.Fatal("Expected hidden after the step")
+	}
+
+	color := box.(api.IColorable).Color()
+	if color.R() != 150 || color.G() != 75 {
+		t.Fatalf("Expected color (150, 75), got %v", color.Color())
+	}
+	if len(l.markers) != 0 {
+		t.Fatal("Expected seeking not to pass markers")
+	}
+
+	// The track gives the box its own palette once and then changes it.
+	if color == shared || shared.AsUInt64() != sharedColor {
+		t.Fatal("Expected the box's original palette to be left alone")
+	}
+	move.Seek(100.0)
+	if box.(api.IColorable).Color() != color || color.R() != 100 {
+		t.Fatalf("Expected the track to reuse its palette, got %v", color.Color())
+	}
+
+	// Playing
+	move.Play()
+	move.Update(100.0)
+	move.Update(50.0)
+	if len(l.markers) != 2 || l.markers[0] != "start" || l.markers[1] != "turn" {
+		t.Fatalf("Expected start and turn markers, got %v", l.markers)
+	}
+
+	move.Update(100.0)
+	if move.IsPlaying() || move.Time() != 200.0 {
+		t.Fatal("Expected the timeline to stop at the end")
+	}
+	expect(t, "x", ship.Position().X(), 0.0)
+}
+
+func runLoop(t *testing.T, grow *timeline.Timeline, ship api.INode) {
+	l := &listener{}
+	grow.SetListener(l)
+	grow.Bind(ship)
+
+	// The first keyframe takes the scale when played.
+	ship.SetScale(2.0)
+	grow.Play()
+
+	grow.Update(25.0)
+	expect(t, "scale", ship.Scale(), 2.5)
+
+	grow.Update(100.0)
+	expect(t, "scale", ship.Scale(), 2.5)
+	if len(l.markers) != 2 || !grow.IsPlaying() {
+		t.Fatalf("Expected a loop marker per loop, got %v", l.markers)
+	}
+
+	grow.Stop()
+	grow.Update(25.0)
+	expect(t, "time", grow.Time(), 25.0)
+}
+
+func runErrors(t *testing.T, world api.IWorld) {
+	if _, err := timeline.Parse([]byte(`{"timelines": {"bad": {"tracks": [
+		{"property": "x", "keys": [{"time": 0, "value": [1], "ease": "wobble-in"}]}]}}}`)); err == nil {
+		t.Fatal("Expected an unknown ease to fail")
+	}
+
+	// Keyframes with the wrong number of values disable the track.
+	timelines, err := timeline.Parse([]byte(`{"timelines": {"bad": {"tracks": [
+		{"property": "position", "keys": [{"time": 0, "value": [1]}]}]}}}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	node := nodes.NewNode()
+	node.Initialize("Node")
+	node.Build(world)
+	timelines["bad"].Bind(node)
+	timelines["bad"].Seek(0.0)
+
+	// The zones example's choreography
+	if _, err := timeline.Load("../examples/assets/zone_timelines.json"); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -9,9 +9,6 @@ import (
 	"github.com/wdevore/RangerGo/api"
 	"github.com/wdevore/RangerGo/engine/animation/tweening"
 	"github.com/wdevore/RangerGo/engine/maths"
-
-	"github.com/tanema/gween"
-	"github.com/tanema/gween/ease"
 )
 
 func TestRunner(t *testing.T) {
@@ -86,15 +83,15 @@ func expoTest() {
 	// }
 }
 
-func gweenTest() {
-	duration := float32(3.0)
-	var tween = gween.New(-5, 10, duration, ease.InExpo)
+func expoInTest() {
+	duration := 3.0
+	var tween = tweening.NewTween(-5, 10, duration, api.EquationExpo, api.EaseIn)
 
-	dt := float32(0.167)
+	dt := 0.167
 	isFinished := false
-	current := float32(0)
-	pCurrent := float32(0.0)
-	et := float32(0.0)
+	current := 0.0
+	pCurrent := 0.0
+	et := 0.0
 
 	for !isFinished {
 		current, isFinished = tween.Update(dt)
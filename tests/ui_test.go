@@ -0,0 +1,156 @@
+package ui
+
+import (
+	"testing"
+
+	"github.com/veandco/go-sdl2/sdl"
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine"
+	"github.com/wdevore/RangerGo/engine/nodes"
+	"github.com/wdevore/RangerGo/engine/nodes/ui"
+)
+
+func TestRunner(t *testing.T) {
+	world := engine.NewWorld("UI", 1.0, "../examples")
+	root := nodes.NewNode()
+	root.Initialize("Root")
+
+	runVerticalLayout(t, world, root)
+	runGridLayout(t, world, root)
+	runFocus(t, world, root)
+	runTextInput(t, world, root)
+}
+
+func keyEvent(code uint32) api.IEvent {
+	event := nodes.NewEvent()
+	event.SetType(api.IOTypeKeyboard)
+	event.SetState(sdl.PRESSED)
+	event.SetKeyCode(code)
+	return event
+}
+
+func runVerticalLayout(t *testing.T, world api.IWorld, root api.INode) {
+	panel := ui.NewPanel("Panel", world, root).(*ui.Panel)
+	a := ui.NewButton("A", world, panel).(*ui.Button)
+	a.SetText("A")
+	b := ui.NewButton("B", world, panel).(*ui.Button)
+	b.SetText("LONGER")
+
+	panel.Layout()
+
+	pad := panel.Theme().Padding
+	_, ah := a.Size()
+	bw, _ := b.Size()
+
+	if a.Position().X() != pad || a.Position().Y() != pad {
+		t.Fatalf("Expected first child at padding, got %v", a.Position())
+	}
+
+	if b.Position().Y() != pad+ah+pad {
+		t.Fatalf("Expected second child below first, got %f", b.Position().Y())
+	}
+
+	// Stretch widens every child to the content width.
+	aw, _ := a.Size()
+	if aw != bw {
+		t.Fatalf("Expected stretched widths, got %f and %f", aw, bw)
+	}
+
+	pw, _ := panel.Size()
+	if pw != bw+pad*2.0 {
+		t.Fatalf("Expected panel to fit content, got %f", pw)
+	}
+}
+
+func runGridLayout(t *testing.T, world api.IWorld, root api.INode) {
+	panel := ui.NewPanel("Grid", world, root).(*ui.Panel)
+	panel.SetLayout(ui.NewGridLayout(2, 0.0))
+
+	cells := []*ui.Label{}
+	for i := 0; i < 3; i++ {
+		l := ui.NewLabel("Cell", world, panel).(*ui.Label)
+		l.SetText("X")
+		cells = append(cells, l)
+	}
+
+	panel.Layout()
+
+	w, h := cells[0].Size()
+	if cells[1].Position().X() != cells[0].Position().X()+w {
+		t.Fatalf("Expected second cell in second column, got %v", cells[1].Position())
+	}
+	if cells[2].Position().Y() != cells[0].Position().Y()+h {
+		t.Fatalf("Expected third cell in second row, got %v", cells[2].Position())
+	}
+}
+
+func runFocus(t *testing.T, world api.IWorld, root api.INode) {
+	panel := ui.NewPanel("Focus", world, root).(*ui.Panel)
+	ui.NewLabel("Label", world, panel)
+	button := ui.NewButton("Button", world, panel).(*ui.Button)
+	check := ui.NewCheckbox("Check", world, panel).(*ui.Checkbox)
+	slider := ui.NewSlider("Slider", world, panel).(*ui.Slider)
+	panel.Layout()
+
+	clicks := 0
+	button.SetAction(func(w api.IWidget) { clicks++ })
+
+	// Labels aren't focusable so Tab goes to the button first.
+	panel.Handle(keyEvent(sdl.K_TAB))
+	if panel.Focused() != button || !button.HasFocus() {
+		t.Fatal("Expected button to have focus")
+	}
+
+	panel.Handle(keyEvent(sdl.K_SPACE))
+	if clicks != 1 {
+		t.Fatalf("Expected one click, got %d", clicks)
+	}
+
+	panel.Handle(keyEvent(sdl.K_DOWN))
+	panel.Handle(keyEvent(sdl.K_RETURN))
+	if !check.IsChecked() || button.HasFocus() {
+		t.Fatal("Expected checkbox to be focused and checked")
+	}
+
+	panel.Handle(keyEvent(sdl.K_TAB))
+	panel.Handle(keyEvent(sdl.K_RIGHT))
+	if slider.Value() < 0.09 || slider.Value() > 0.11 {
+		t.Fatalf("Expected slider to step, got %f", slider.Value())
+	}
+
+	// Wraps back to the first focusable widget, skipping disabled ones.
+	check.SetEnabled(false)
+	panel.Handle(keyEvent(sdl.K_TAB))
+	if panel.Focused() != button {
+		t.Fatal("Expected focus to wrap to the button")
+	}
+	panel.Handle(keyEvent(sdl.K_TAB))
+	if panel.Focused() != slider {
+		t.Fatal("Expected focus to skip the disabled checkbox")
+	}
+}
+
+func runTextInput(t *testing.T, world api.IWorld, root api.INode) {
+	panel := ui.NewPanel("Input", world, root).(*ui.Panel)
+	input := ui.NewTextInput("Input", world, panel).(*ui.TextInput)
+	input.SetMaxLength(5)
+	panel.SetFocused(input)
+
+	text := nodes.NewEvent()
+	text.SetType(api.IOTypeTextInput)
+	text.SetText("héllo world")
+	panel.Handle(text)
+
+	if input.Text() != "héllo" {
+		t.Fatalf("Expected 'héllo', got '%s'", input.Text())
+	}
+
+	panel.Handle(keyEvent(sdl.K_HOME))
+	panel.Handle(keyEvent(sdl.K_DELETE))
+	panel.Handle(keyEvent(sdl.K_END))
+	panel.Handle(keyEvent(sdl.K_BACKSPACE))
+
+	if input.Text() != "éll" || input.Caret() != 3 {
+		t.Fatalf("Expected 'éll' with caret 3, got '%s' %d", input.Text(), input.Caret())
+	}
+}
@@ -3,12 +3,104 @@ package vectorfonts
 import (
 	// "fmt"
 
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 
+	"github.com/wdevore/RangerGo/api"
 	"github.com/wdevore/RangerGo/engine/rendering"
 )
 
 func TestRunner(t *testing.T) {
+	runVectorFont(t)
+	runFallbackExtents(t)
+	runRasterFont(t)
+}
+
+func runVectorFont(t *testing.T) {
 	vf := rendering.NewVectorFont()
-	vf.Initialize("vector_font.data")
+	vf.Initialize("vector_font.data", "../examples")
+
+	if !vf.HasGlyph('A') {
+		t.Fatal("Expected glyph 'A'")
+	}
+
+	if vf.HasGlyph('Ω') {
+		t.Fatal("Expected no glyph for 'Ω'")
+	}
+
+	if len(vf.Glyph('Ω')) == 0 {
+		t.Fatal("Expected fallback glyph for 'Ω'")
+	}
+}
+
+func runFallbackExtents(t *testing.T) {
+	dir, err := ioutil.TempDir("", "vectorfont")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// A font whose only glyph is a narrow '?'
+	data := "horizontalOffset 1.3\nverticalOffset 1.2\n?\n-0.3 0.0 0.2 -1.0\n||\n"
+	os.Mkdir(filepath.Join(dir, "assets"), 0755)
+	if err := ioutil.WriteFile(filepath.Join(dir, "assets", "font.data"), []byte(data), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	fonts := []api.IVectorFont{rendering.NewVectorFont(), rendering.NewVectorMapFont()}
+	for _, vf := range fonts {
+		vf.Initialize("font.data", dir)
+
+		// Missing runes are measured as the '?' glyph they are drawn with.
+		minX, maxX := vf.Extents('Ω')
+		if minX != -0.3 || maxX != 0.2 {
+			t.Fatalf("Expected the '?' extents, got %f..%f", minX, maxX)
+		}
+		if vf.Advance('Ω') != vf.Advance('?') {
+			t.Fatalf("Expected the '?' advance, got %f", vf.Advance('Ω'))
+		}
+	}
+}
+
+func runRasterFont(t *testing.T) {
+	rf := rendering.NewRasterFont()
+	rf.Initialize("raster_font.data", "../examples")
+
+	for _, c := range "AÉñΩλ┼╔" {
+		if !rf.HasGlyph(c) {
+			t.Fatalf("Expected glyph for '%c'", c)
+		}
+	}
+
+	// The Greek block includes the tonos and dialytika forms
+	for _, c := range "ΐΪΫάέήίΰ" {
+		if !rf.HasGlyph(c) {
+			t.Fatalf("Expected glyph for '%c'", c)
+		}
+	}
+
+	if alpha := rf.Glyph('ά'); alpha[0] != 0x38 || alpha[2] != 0x6E {
+		t.Fatalf("Expected alpha with tonos, got % X", alpha)
+	}
+
+	// A box-drawing cross has a full horizontal row
+	cross := rf.Glyph('┼')
+	if cross[3] != 0xFF {
+		t.Fatalf("Expected 0xFF, got 0x%02X", cross[3])
+	}
+
+	// Runes outside the font render the replacement glyph
+	if rf.HasGlyph('一') {
+		t.Fatal("Expected no glyph for '一'")
+	}
+
+	fallback := rf.Glyph('一')
+	replacement := rf.Glyph('�')
+	for i := range fallback {
+		if fallback[i] != replacement[i] {
+			t.Fatal("Expected fallback to be the replacement glyph")
+		}
+	}
 }
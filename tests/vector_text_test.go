@@ -0,0 +1,94 @@
+package vectortext
+
+import (
+	"math"
+	"testing"
+
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine"
+	"github.com/wdevore/RangerGo/engine/geometry"
+	"github.com/wdevore/RangerGo/engine/nodes"
+	"github.com/wdevore/RangerGo/engine/nodes/custom"
+)
+
+func TestRunner(t *testing.T) {
+	world := engine.NewWorld("Vector text", 1.0, "../examples")
+	root := nodes.NewNode()
+	root.Initialize("Root")
+
+	runRebuild(t, world, root)
+	runMultiLine(t, world, root)
+	runAlignment(t, world, root)
+}
+
+func runRebuild(t *testing.T, world api.IWorld, root api.INode) {
+	text := custom.NewVectorTextNode(world, root)
+	text.SetText("AB")
+	text.ReBuild()
+	w1, _ := text.Bounds().Dimesions()
+
+	// Rebuilding must not accumulate the previous mesh
+	text.ReBuild()
+	w2, _ := text.Bounds().Dimesions()
+
+	if w1 != w2 || w1 <= 0.0 {
+		t.Fatalf("Expected equal widths, got %f and %f", w1, w2)
+	}
+}
+
+func runMultiLine(t *testing.T, world api.IWorld, root api.INode) {
+	text := custom.NewVectorTextNode(world, root)
+	text.SetText("A\nA")
+	text.ReBuild()
+
+	_, h := text.Bounds().Dimesions()
+	vOffset := world.VectorFont().VerticalOffset()
+
+	// Two lines of 'A' span one glyph height plus one line offset.
+	if h < vOffset+0.9 || h > vOffset+1.1 {
+		t.Fatalf("Expected height ~%f, got %f", vOffset+1.0, h)
+	}
+}
+
+func runAlignment(t *testing.T, world api.IWorld, root api.INode) {
+	text := custom.NewVectorTextNode(world, root)
+	text.SetText("HELLO")
+
+	for _, proportional := range []bool{false, true} {
+		text.SetProportional(proportional)
+
+		text.SetAlignment(api.TextAlignCenter)
+		text.ReBuild()
+		center := (text.Bounds().Min().X() + text.Bounds().Max().X()) / 2.0
+		if math.Abs(center) > 1e-6 {
+			t.Fatalf("Proportional %v: expected centered text, got center %f", proportional, center)
+		}
+
+		text.SetAlignment(api.TextAlignRight)
+		text.ReBuild()
+		if right := text.Bounds().Max().X(); math.Abs(right) > 1e-6 {
+			t.Fatalf("Proportional %v: expected right aligned text, got right %f", proportional, right)
+		}
+
+		w, _ := text.Bounds().Dimesions()
+		if math.Abs(w-text.Measure("HELLO")) > 1e-6 {
+			t.Fatalf("Proportional %v: expected the measured width %f, got %f", proportional, text.Measure("HELLO"), w)
+		}
+	}
+
+	text.SetAlignment(api.TextAlignCenter)
+	text.ReBuild()
+
+	if !text.PointInside(geometry.NewPointUsing(0.0, -0.5)) {
+		t.Fatal("Expected point to be inside text bounds")
+	}
+
+	text.SetMaxWidth(2.0)
+	text.SetText("HELLO HELLO")
+	text.ReBuild()
+
+	_, h := text.Bounds().Dimesions()
+	if h < world.VectorFont().VerticalOffset() {
+		t.Fatalf("Expected wrapped text, got height %f", h)
+	}
+}
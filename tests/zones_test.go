@@ -0,0 +1,168 @@
+package zones
+
+import (
+	"testing"
+
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine/geometry"
+	"github.com/wdevore/RangerGo/engine/misc"
+)
+
+func TestRunner(t *testing.T) {
+	runHysteresis(t)
+	runShapes(t)
+	runManager(t)
+}
+
+func runHysteresis(t *testing.T) {
+	zone := misc.NewCircleZone()
+	cz := zone.(*misc.CircleZone)
+	cz.SetRadi(10.0, 20.0)
+
+	p := geometry.NewPoint()
+	step := func(x float64) (int, bool) {
+		p.SetByComp(x, 0.0)
+		return zone.Update(p)
+	}
+
+	// The outer region alone doesn't enter.
+	if _, changed := step(15.0); changed {
+		t.Fatal("Expected no change in the outer region")
+	}
+	if state, changed := step(5.0); !changed || state != api.CrossStateEntered {
+		t.Fatalf("Expected to enter, got %d", state)
+	}
+	// Back in the outer region is still inside.
+	if _, changed := step(15.0); changed {
+		t.Fatal("Expected no exit in the outer region")
+	}
+	if state, changed := step(25.0); !changed || state != api.CrossStateExited {
+		t.Fatalf("Expected to exit, got %d", state)
+	}
+
+	// Jumping straight into the inner region enters.
+	if state, changed := step(0.0); !changed || state != api.CrossStateEntered {
+		t.Fatalf("Expected to enter directly, got %d", state)
+	}
+}
+
+func runShapes(t *testing.T) {
+	inner := geometry.NewPolygon()
+	outer := geometry.NewPolygon()
+	for _, v := range [][2]float64{{-1.0, -1.0}, {1.0, -1.0}, {0.0, 1.0}} {
+		inner.AddVertex(v[0]*10.0, v[1]*10.0)
+		outer.AddVertex(v[0]*20.0, v[1]*20.0)
+	}
+	inner.Build()
+	outer.Build()
+
+	poly := misc.NewPolygonZone(inner, outer)
+	poly.(*misc.PolygonZone).SetPosition(100.0, 0.0)
+
+	rect := misc.NewRectangleZone()
+	rect.(*misc.RectangleZone).SetSizes(10.0, 10.0, 30.0, 20.0)
+	rect.(*misc.RectangleZone).SetPosition(-100.0, 0.0)
+
+	cases := []struct {
+		zone   api.IZone
+		x, y   float64
+		region int
+	}{
+		{poly, 100.0, 0.0, api.ZoneStateEnteredInner},
+		{poly, 100.0, -15.0, api.ZoneStateEnteredOuter},
+		{poly, 0.0, 0.0, api.ZoneStateObjectIsOutside},
+		{rect, -100.0, 0.0, api.ZoneStateEnteredInner},
+		{rect, -88.0, 0.0, api.ZoneStateEnteredOuter},
+		{rect, -100.0, 15.0, api.ZoneStateObjectIsOutside},
+	}
+
+	for i, c := range cases {
+		if r := c.zone.PointInside(geometry.NewPointUsing(c.x, c.y)); r != c.region {
+			t.Fatalf("Case %d: expected region %d, got %d", i, c.region, r)
+		}
+	}
+}
+
+type event struct {
+	state, zone, object int
+}
+
+type recorder struct {
+	events []event
+}
+
+func (r *recorder) Notify(state, zoneID, objectID int) {
+	r.events = append(r.events, event{state, zoneID, objectID})
+}
+
+func (r *recorder) count(state, zoneID, objectID int) int {
+	n := 0
+	for _, e := range r.events {
+		if e == (event{state, zoneID, objectID}) {
+			n++
+		}
+	}
+	return n
+}
+
+func runManager(t *testing.T) {
+	zone := misc.NewCircleZone()
+	zone.(*misc.CircleZone).SetRadi(10.0, 20.0)
+
+	man := misc.NewZoneManager()
+	man.AddZone(1, zone)
+
+	rec := &recorder{}
+	man.Subscribe(rec)
+
+	a := geometry.NewPointUsing(0.0, 0.0)
+	b := geometry.NewPointUsing(50.0, 0.0)
+
+	// Each object has its own crossing state.
+	man.Check(100, a)
+	man.Check(200, b)
+	man.Check(100, a)
+
+	if rec.count(api.CrossStateEntered, 1, 100) != 1 || rec.count(api.CrossStateInside, 1, 100) != 1 {
+		t.Fatalf("Expected enter then stay for 100, got %v", rec.events)
+	}
+	if rec.count(api.CrossStateEntered, 1, 200) != 0 || !man.Inside(1, 100) || man.Inside(1, 200) {
+		t.Fatalf("Expected only 100 inside, got %v", rec.events)
+	}
+
+	// Object 200 enters while 100 moves to the outer region and stays.
+	b.SetByComp(5.0, 0.0)
+	a.SetByComp(15.0, 0.0)
+	man.Check(200, b)
+	man.Check(100, a)
+	if rec.count(api.CrossStateEntered, 1, 200) != 1 || rec.count(api.CrossStateInside, 1, 100) != 2 {
+		t.Fatalf("Expected 200 to enter and 100 to stay, got %v", rec.events)
+	}
+
+	a.SetByComp(30.0, 0.0)
+	man.Check(100, a)
+	if rec.count(api.CrossStateExited, 1, 100) != 1 || man.Inside(1, 100) {
+		t.Fatalf("Expected 100 to exit, got %v", rec.events)
+	}
+
+	// Forgetting an object inside exits it.
+	man.Forget(200)
+	if rec.count(api.CrossStateExited, 1, 200) != 1 {
+		t.Fatalf("Expected forgetting 200 to exit, got %v", rec.events)
+	}
+
+	// Removing a zone exits its objects and stops notifications.
+	man.Check(300, geometry.NewPointUsing(0.0, 0.0))
+	man.RemoveZone(1)
+	if rec.count(api.CrossStateExited, 1, 300) != 1 {
+		t.Fatalf("Expected removing the zone to exit 300, got %v", rec.events)
+	}
+
+	man.Unsubscribe(rec)
+	n := len(rec.events)
+	man.AddZone(2, zone)
+	man.Check(300, geometry.NewPointUsing(0.0, 0.0))
+	if len(rec.events) != n {
+		t.Fatal("Expected no events after unsubscribing")
+	}
+}
@@ -0,0 +1,116 @@
+package zorder
+
+import (
+	"testing"
+
+	"github.com/wdevore/RangerGo/api"
+	"github.com/wdevore/RangerGo/engine"
+	"github.com/wdevore/RangerGo/engine/misc"
+	"github.com/wdevore/RangerGo/engine/nodes"
+)
+
+func TestRunner(t *testing.T) {
+	world := engine.NewWorld("ZOrder", 1.0, "../examples")
+
+	runSorting(t, world)
+	runAdding(t, world)
+	runDragRaise(t, world)
+}
+
+func newChild(name string, world api.IWorld, parent api.INode) api.INode {
+	n := nodes.NewNode()
+	n.Initialize(name)
+	n.SetParent(parent)
+	parent.AddChild(n)
+	n.Build(world)
+	return n
+}
+
+func runSorting(t *testing.T, world api.IWorld) {
+	root := newRoot(world)
+	a := newChild("A", world, root)
+	b := newChild("B", world, root)
+	c := newChild("C", world, root)
+
+	expectOrder(t, root, "A", "B", "C")
+
+	a.SetZIndex(1)
+	expectOrder(t, root, "B", "C", "A")
+
+	// Layers take precedence over z-indices.
+	c.SetDrawLayer(api.DrawLayerBackground)
+	expectOrder(t, root, "C", "B", "A")
+
+	// B joins A's z-index and, being last, is on top.
+	root.BringToFront(b)
+	if b.ZIndex() != 1 {
+		t.Fatalf("Expected B's z-index to be raised to 1, got %d", b.ZIndex())
+	}
+	expectOrder(t, root, "C", "A", "B")
+
+	// C is alone in its layer so only the order within it changes.
+	root.SendToBack(b)
+	expectOrder(t, root, "C", "B", "A")
+}
+
+func runAdding(t *testing.T, world api.IWorld) {
+	root := newRoot(world)
+	layered := func(name string, z int) api.INode {
+		n := nodes.NewNode()
+		n.Initialize(name)
+		n.SetZIndex(z)
+		n.Build(world)
+		return n
+	}
+
+	// Added children are in order once read.
+	root.AddChild(layered("X", 2))
+	root.AddChild(layered("Y", 0))
+	root.AddChild(layered("Z", 1))
+	root.AddChild(layered("W", 0))
+	expectOrder(t, root, "Y", "W", "Z", "X")
+
+	// Inserted children stay at the index among their equals and
+	// otherwise move next to them.
+	root.InsertAt(1, layered("V", 0))
+	root.InsertAt(0, layered("U", 1))
+	expectOrder(t, root, "Y", "V", "W", "U", "Z", "X")
+	if root.IndexOf(root.FindByName("U")) != 3 {
+		t.Fatalf("Expected U at 3, got %d", root.IndexOf(root.FindByName("U")))
+	}
+}
+
+func runDragRaise(t *testing.T, world api.IWorld) {
+	root := newRoot(world)
+	a := newChild("A", world, root)
+	newChild("B", world, root)
+
+	drag := misc.NewDragState()
+	drag.SetRaiseOnDrag(true)
+
+	drag.SetButtonStateUsing(0, 0, 1, 1, a)
+	expectOrder(t, root, "B", "A")
+
+	// The z-index is restored on release but A stays in front of its equals.
+	drag.SetButtonStateUsing(0, 0, 1, 0, a)
+	if a.ZIndex() != 0 {
+		t.Fatalf("Expected A's z-index restored to 0, got %d", a.ZIndex())
+	}
+	expectOrder(t, root, "B", "A")
+}
+
+func newRoot(world api.IWorld) api.INode {
+	root := nodes.NewNode()
+	root.Initialize("Root")
+	root.Build(world)
+	return root
+}
+
+func expectOrder(t *testing.T, parent api.INode, names ...string) {
+	children := parent.Children()
+	for i, name := range names {
+		if children[i].Name() != name {
+			t.Fatalf("Expected %s at %d, got %s", name, i, children[i].Name())
+		}
+	}
+}